@@ -0,0 +1,59 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"emulation/domain"
+)
+
+// APIKey binds a key to the agents its holder may command. An admin key may command any agent,
+// change the configuration and control the system.
+type APIKey struct {
+	Key            string                   `json:"key"`
+	Name           string                   `json:"name"`
+	Admin          bool                     `json:"admin,omitempty"`
+	OrderingAgents []domain.OrderingAgentId `json:"orderingAgents,omitempty"`
+	Producers      []domain.ProducerId      `json:"producers,omitempty"`
+}
+
+// APIKeys are looked up by the key
+type APIKeys map[string]APIKey
+
+// LoadAPIKeys reads and validates the API keys from a JSON file with an array of keys
+func LoadAPIKeys(path string) (APIKeys, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API keys file: %w", err)
+	}
+
+	var keys []APIKey
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse API keys file: %w", err)
+	}
+	return NewAPIKeys(keys)
+}
+
+func NewAPIKeys(keys []APIKey) (APIKeys, error) {
+	result := APIKeys{}
+	for _, key := range keys {
+		if key.Key == "" || key.Name == "" {
+			return nil, fmt.Errorf("API key [%s] must have a key and a name", key.Name)
+		}
+		if _, ok := result[key.Key]; ok {
+			return nil, fmt.Errorf("API key [%s] is duplicated", key.Name)
+		}
+		result[key.Key] = key
+	}
+	return result, nil
+}
+
+// Authenticate finds the API key, ErrNotFound is returned for an unknown one
+func (k APIKeys) Authenticate(key string) (APIKey, error) {
+	result, ok := k[key]
+	if !ok {
+		return APIKey{}, domain.ErrNotFound
+	}
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"emulation/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeys(t *testing.T) {
+	t.Run(`Given an API keys file
+		When it is loaded
+		Then the keys are authenticated by their values`, func(t *testing.T) {
+
+		path := filepath.Join(t.TempDir(), "api-keys.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[
+			{"key": "secret-a", "name": "team-a", "orderingAgents": ["c1"], "producers": ["p1"]},
+			{"key": "secret-admin", "name": "admin", "admin": true}
+		]`), 0o600))
+
+		keys, err := LoadAPIKeys(path)
+		require.NoError(t, err)
+
+		key, err := keys.Authenticate("secret-a")
+		require.NoError(t, err)
+		require.Equal(t, APIKey{
+			Key:            "secret-a",
+			Name:           "team-a",
+			OrderingAgents: []domain.OrderingAgentId{"c1"},
+			Producers:      []domain.ProducerId{"p1"},
+		}, key)
+
+		_, err = keys.Authenticate("secret-b")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run(`Given duplicated or incomplete keys
+		When the keys are created
+		Then an error is returned`, func(t *testing.T) {
+
+		_, err := NewAPIKeys([]APIKey{{Key: "k", Name: "a"}, {Key: "k", Name: "b"}})
+		require.Error(t, err)
+		_, err = NewAPIKeys([]APIKey{{Key: "k"}})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,199 @@
+package application
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"emulation/domain"
+)
+
+var (
+	runsBucket   = []byte("runs")
+	cyclesBucket = []byte("cycles")
+	eventsBucket = []byte("events")
+)
+
+const runIdPrefix = "run-"
+
+// BoltStore is the Store in a bbolt file. The runs are keyed by their sequence numbers,
+// the cycles and the events of a run are in its nested buckets keyed by the cycle and the event numbers.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+var _ Store = &BoltStore{}
+
+// OpenBoltStore opens the store file, it is created when missing
+func OpenBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store: %w", err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{runsBucket, cyclesBucket, eventsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open store: %w", err)
+	}
+	return &BoltStore{db}, nil
+}
+
+func key(n uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, n)
+}
+
+// runKey parses the run id, an id not issued by the store is not found
+func runKey(id string) ([]byte, error) {
+	s, ok := strings.CutPrefix(id, runIdPrefix)
+	n, err := strconv.ParseUint(s, 10, 64)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("run %s: %w", id, domain.ErrNotFound)
+	}
+	return key(n), nil
+}
+
+func getRun(tx *bolt.Tx, id string) ([]byte, RunInfo, error) {
+	var run RunInfo
+	k, err := runKey(id)
+	if err != nil {
+		return nil, run, err
+	}
+	data := tx.Bucket(runsBucket).Get(k)
+	if data == nil {
+		return nil, run, fmt.Errorf("run %s: %w", id, domain.ErrNotFound)
+	}
+	return k, run, json.Unmarshal(data, &run)
+}
+
+func putJSON(b *bolt.Bucket, k []byte, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return b.Put(k, data)
+}
+
+func (s *BoltStore) CreateRun(run RunInfo) (string, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		runs := tx.Bucket(runsBucket)
+		n, err := runs.NextSequence()
+		if err != nil {
+			return err
+		}
+		run.Id = runIdPrefix + strconv.FormatUint(n, 10)
+		return putJSON(runs, key(n), run)
+	})
+	return run.Id, err
+}
+
+func (s *BoltStore) AddCycle(runId string, cycle CycleRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		k, run, err := getRun(tx, runId)
+		if err != nil {
+			return err
+		}
+		cycles, err := tx.Bucket(cyclesBucket).CreateBucketIfNotExists(k)
+		if err != nil {
+			return err
+		}
+		if err := putJSON(cycles, key(uint64(cycle.Cycle)), cycle); err != nil {
+			return err
+		}
+		run.Cycles++
+		run.Score += cycle.Result.Score
+		return putJSON(tx.Bucket(runsBucket), k, run)
+	})
+}
+
+func (s *BoltStore) AddEvents(runId string, events []Event) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		k, _, err := getRun(tx, runId)
+		if err != nil {
+			return err
+		}
+		b, err := tx.Bucket(eventsBucket).CreateBucketIfNotExists(k)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := putJSON(b, key(event.Seq), event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BoltStore) Runs() ([]RunInfo, error) {
+	var result []RunInfo
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(runsBucket).ForEach(func(_, data []byte) error {
+			var run RunInfo
+			if err := json.Unmarshal(data, &run); err != nil {
+				return err
+			}
+			run.Config = nil
+			result = append(result, run)
+			return nil
+		})
+	})
+	return result, err
+}
+
+func (s *BoltStore) Run(id string) (RunInfo, error) {
+	var run RunInfo
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		_, run, err = getRun(tx, id)
+		return err
+	})
+	return run, err
+}
+
+// nested decodes all values of the run's nested bucket in the key order
+func nested[T any](s *BoltStore, bucket []byte, runId string) ([]T, error) {
+	var result []T
+	err := s.db.View(func(tx *bolt.Tx) error {
+		k, _, err := getRun(tx, runId)
+		if err != nil {
+			return err
+		}
+		b := tx.Bucket(bucket).Bucket(k)
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(_, data []byte) error {
+			var v T
+			if err := json.Unmarshal(data, &v); err != nil {
+				return err
+			}
+			result = append(result, v)
+			return nil
+		})
+	})
+	return result, err
+}
+
+func (s *BoltStore) Cycles(runId string) ([]CycleRecord, error) {
+	return nested[CycleRecord](s, cyclesBucket, runId)
+}
+
+func (s *BoltStore) Events(runId string) ([]Event, error) {
+	return nested[Event](s, eventsBucket, runId)
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"emulation/domain"
+)
+
+// checkpointRetention is the number of the last cycles whose checkpoints are kept
+const checkpointRetention = 100
+
+// checkpoint keeps the state at the start of the current cycle, after the bots' investments.
+// The market history isn't kept, the records before the cycle are the same in all later states.
+func (e *Emulator) checkpoint() {
+	snapshot, err := e.snapshot()
+	if err != nil {
+		slog.Error("emulator.checkpoint.failed",
+			slog.String("error", err.Error()))
+		return
+	}
+	snapshot.System.MarketHistory = nil
+	cycle := snapshot.System.CycleCounter
+	e.checkpoints[cycle] = snapshot
+	if cycle > checkpointRetention {
+		delete(e.checkpoints, cycle-checkpointRetention)
+	}
+}
+
+// Checkpoints returns the sorted cycles the emulator can rewind to,
+// only the checkpoints of the last checkpointRetention cycles are kept
+func (e *Emulator) Checkpoints() []uint {
+	e.rwMu.RLock()
+	defer e.rwMu.RUnlock()
+	return slices.Sorted(maps.Keys(e.checkpoints))
+}
+
+// Checkpoint returns the state at the start of the cycle
+func (e *Emulator) Checkpoint(cycle uint) (Snapshot, error) {
+	e.rwMu.RLock()
+	defer e.rwMu.RUnlock()
+	return e.checkpointSnapshot(cycle)
+}
+
+func (e *Emulator) checkpointSnapshot(cycle uint) (Snapshot, error) {
+	snapshot, ok := e.checkpoints[cycle]
+	if !ok {
+		return Snapshot{}, fmt.Errorf("checkpoint of cycle %d: %w", cycle, domain.ErrNotFound)
+	}
+	if cycle > 1 {
+		snapshot.System.MarketHistory = e.system.MarketHistory(1, cycle-1)
+	}
+	return snapshot, nil
+}
+
+// Rewind returns the simulation to the start of the cycle, the later cycles are dropped
+func (e *Emulator) Rewind(cycle uint) error {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+
+	slog.Info("emulator.rewind.started",
+		slog.Int("cycle", int(cycle)))
+
+	snapshot, err := e.checkpointSnapshot(cycle)
+	if err != nil {
+		return err
+	}
+	return e.restore(snapshot, cycle)
+}
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"emulation/domain"
+	"emulation/strategy"
+)
+
+func TestCheckpoints(t *testing.T) {
+	config := testConfig()
+	config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{
+		"c1": {Name: strategy.ProportionalToCapacityName},
+	}
+	// playCycle plays a cycle with the producer restoring its capacity or not
+	playCycle := func(t *testing.T, e *Emulator, restore bool) domain.CycleResult {
+		require.NoError(t, e.ProducingAgentAction("p1", domain.ProducingAgentCommand{DoRestoration: restore}))
+		require.NoError(t, e.StartOrdering())
+		result, err := e.CompleteCycle()
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run(`Given an emulator played for four cycles
+		When it is rewound to the second cycle and plays the same commands
+		Then the later checkpoints are dropped
+		And the cycles are played again with the same results`, func(t *testing.T) {
+
+		e, err := NewEmulator(config, 5)
+		require.NoError(t, err)
+		defer e.Close()
+		var results []domain.CycleResult
+		for i := range 4 {
+			results = append(results, playCycle(t, e, i == 1))
+		}
+		history := e.GetMarketHistory(0, 10)
+		require.Equal(t, []uint{1, 2, 3, 4, 5}, e.Checkpoints())
+
+		require.NoError(t, e.Rewind(2))
+		require.Equal(t, []uint{1, 2}, e.Checkpoints())
+		require.Equal(t, "OrdersPlacement", e.GetSystemInfo().State)
+		require.Equal(t, int64(2), e.GetSystemInfo().CycleCounter)
+		require.Empty(t, cmp.Diff(history[:1], e.GetMarketHistory(0, 10)))
+		for i := 1; i < 4; i++ {
+			require.Empty(t, cmp.Diff(results[i], playCycle(t, e, i == 1)))
+		}
+		require.Empty(t, cmp.Diff(history, e.GetMarketHistory(0, 10)))
+	})
+
+	t.Run(`Given an emulator played for more cycles than the checkpoints are kept
+		When its checkpoints are listed
+		Then only the checkpoints of the last cycles are left`, func(t *testing.T) {
+
+		e, err := NewEmulator(config, 5)
+		require.NoError(t, err)
+		defer e.Close()
+		for range checkpointRetention + 1 {
+			playCycle(t, e, false)
+		}
+
+		checkpoints := e.Checkpoints()
+		require.Len(t, checkpoints, checkpointRetention)
+		require.Equal(t, uint(3), checkpoints[0])
+		require.Equal(t, uint(checkpointRetention+2), checkpoints[len(checkpoints)-1])
+		_, err = e.Checkpoint(2)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run(`Given a session played for four cycles
+		When a branch is forked at the second cycle with another configuration and plays other commands
+		Then the source session stays intact`, func(t *testing.T) {
+
+		sessions := NewSessions()
+		_, source, err := sessions.Create("main", config, 5)
+		require.NoError(t, err)
+		for range 4 {
+			playCycle(t, source, false)
+		}
+		history := source.GetMarketHistory(0, 10)
+
+		branchConfig := *config
+		branchConfig.CycleEmission = 200
+		id, branch, err := sessions.Fork("", "main", 2, &branchConfig)
+		require.NoError(t, err)
+		require.Equal(t, "session-1", id)
+		require.Equal(t, int64(2), branch.GetSystemInfo().CycleCounter)
+		require.Equal(t, domain.Tokens(200), branch.GetConfig().CycleEmission)
+		require.Empty(t, cmp.Diff(history[:1], branch.GetMarketHistory(0, 10)))
+		playCycle(t, branch, true)
+
+		require.Equal(t, int64(5), source.GetSystemInfo().CycleCounter)
+		require.Equal(t, domain.Tokens(100), source.GetConfig().CycleEmission)
+		require.Empty(t, cmp.Diff(history, source.GetMarketHistory(0, 10)))
+		require.Equal(t, []uint{1, 2, 3, 4, 5}, source.Checkpoints())
+	})
+
+	t.Run(`Given a session
+		When a branch is forked at a cycle without a checkpoint
+		Then it is not found`, func(t *testing.T) {
+
+		sessions := NewSessions()
+		_, _, err := sessions.Create("main", config, 5)
+		require.NoError(t, err)
+
+		_, _, err = sessions.Fork("", "main", 7, nil)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.Equal(t, []string{"main"}, sessions.List())
+	})
+}
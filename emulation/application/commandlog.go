@@ -0,0 +1,221 @@
+package application
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"emulation/domain"
+)
+
+// ErrDiverged is returned when the replayed game differs from the recorded one
+var ErrDiverged = errors.New("replay diverged")
+
+type CommandKind string
+
+const (
+	// CommandReset starts a game with the config and the seed
+	CommandReset CommandKind = "reset"
+	// CommandRestore starts a game from the snapshot
+	CommandRestore CommandKind = "restore"
+	// CommandRewind starts the game again from the snapshot of the checkpoint without the market history,
+	// the history before the cycle of the command is kept from the rewound game
+	CommandRewind        CommandKind = "rewind"
+	CommandProducing     CommandKind = "producing"
+	CommandOrdering      CommandKind = "ordering"
+	CommandOrderingPass  CommandKind = "orderingPass"
+	CommandStartOrdering CommandKind = "startOrdering"
+	// CommandCompleteCycle has the result of the cycle to check the replay
+	CommandCompleteCycle CommandKind = "completeCycle"
+)
+
+// Command is an accepted command of the command log, the fields besides the kind depend on it
+type Command struct {
+	Seq       uint64                        `json:"seq"`
+	Kind      CommandKind                   `json:"kind"`
+	Cycle     uint                          `json:"cycle"`
+	Config    *domain.Configuration         `json:"config,omitempty"`
+	Seed      uint64                        `json:"seed,omitempty"`
+	Snapshot  *Snapshot                     `json:"snapshot,omitempty"`
+	AgentId   string                        `json:"agentId,omitempty"`
+	Producing *domain.ProducingAgentCommand `json:"producing,omitempty"`
+	Ordering  *domain.OrderingAgentCommand  `json:"ordering,omitempty"`
+	Result    *domain.CycleResult           `json:"result,omitempty"`
+}
+
+// commandLog is the append-only log of the commands accepted by the emulator, guarded by the emulator lock.
+// Only the commands of the current game are kept in memory, a reset or a restore drops the earlier ones.
+type commandLog struct {
+	commands []Command
+	// seq is the number of the last command
+	seq uint64
+	// out gets every command as a JSON line
+	out io.Writer
+}
+
+func (l *commandLog) append(c Command) {
+	l.seq++
+	c.Seq = l.seq
+	if c.Kind == CommandReset || c.Kind == CommandRestore {
+		l.commands = nil
+	}
+	l.commands = append(l.commands, c)
+	if l.out != nil {
+		if err := writeCommand(l.out, c); err != nil {
+			slog.Error("emulator.command_log.write_failed",
+				slog.Uint64("seq", c.Seq),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+func writeCommand(w io.Writer, c Command) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func (e *Emulator) record(c Command) {
+	c.Cycle = e.system.GetSystemInfo().CycleCounter
+	e.commands.append(c)
+}
+
+// Commands returns the command log of the current game from the sequence number on,
+// the first command of the emulator has number 1
+func (e *Emulator) Commands(fromSeq uint64) []Command {
+	e.rwMu.RLock()
+	defer e.rwMu.RUnlock()
+	from := 0
+	if commands := e.commands.commands; len(commands) > 0 && fromSeq > commands[0].Seq {
+		from = int(min(uint64(len(commands)), fromSeq-commands[0].Seq))
+	}
+	return slices.Clone(e.commands.commands[from:])
+}
+
+// RecordCommands writes the command log of the current game to w as JSON lines and then every new command as it is accepted
+func (e *Emulator) RecordCommands(w io.Writer) error {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+	for _, c := range e.commands.commands {
+		if err := writeCommand(w, c); err != nil {
+			return err
+		}
+	}
+	e.commands.out = w
+	return nil
+}
+
+// ReadCommands decodes the command log written as JSON lines or as a JSON array
+func ReadCommands(r io.Reader) ([]Command, error) {
+	br := bufio.NewReader(r)
+	data, err := br.Peek(1)
+	for err == nil && len(bytes.TrimSpace(data)) == 0 {
+		br.Discard(1)
+		data, err = br.Peek(1)
+	}
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(br)
+	if data[0] == '[' {
+		var commands []Command
+		return commands, dec.Decode(&commands)
+	}
+	var commands []Command
+	for {
+		var c Command
+		if err := dec.Decode(&c); err == io.EOF {
+			return commands, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("command %d: %w", len(commands)+1, err)
+		}
+		commands = append(commands, c)
+	}
+}
+
+// Replay plays the command log through a new domain.System and checks the recorded cycle results,
+// the first difference is returned as ErrDiverged. A positive until stops the replay as soon as the system
+// reaches the cycle, the system is returned in the state of that moment.
+func Replay(commands []Command, until uint, events *domain.EventBus) (*domain.System, []domain.CycleResult, error) {
+	var system *domain.System
+	var results []domain.CycleResult
+	for _, c := range commands {
+		if c.Kind != CommandReset && c.Kind != CommandRestore && c.Kind != CommandRewind {
+			if system == nil {
+				return nil, results, fmt.Errorf("command [%d] %s: no game started", c.Seq, c.Kind)
+			}
+			if until > 0 && system.GetSystemInfo().CycleCounter >= until {
+				return system, results, nil
+			}
+		}
+		var err error
+		switch c.Kind {
+		case CommandReset:
+			if c.Config == nil {
+				return nil, results, fmt.Errorf("command [%d] %s: no configuration", c.Seq, c.Kind)
+			}
+			idGen := domain.SequentialIdGenerator(0)
+			system = domain.NewSystem(&idGen, c.Config, domain.NewConsumers(c.Config.Consumers, c.Seed), events)
+		case CommandRestore:
+			s := c.Snapshot
+			if s == nil || s.Config == nil {
+				return nil, results, fmt.Errorf("command [%d] %s: no snapshot", c.Seq, c.Kind)
+			}
+			idGen := domain.SequentialIdGenerator(s.NextOrderId)
+			system, err = domain.RestoreSystem(&idGen, s.Config, domain.NewConsumers(s.Config.Consumers, s.Seed), events, s.System)
+		case CommandRewind:
+			s := lo.FromPtr(c.Snapshot)
+			if system == nil || s.Config == nil {
+				return nil, results, fmt.Errorf("command [%d] %s: no game or no snapshot", c.Seq, c.Kind)
+			}
+			if c.Cycle > 1 {
+				s.System.MarketHistory = slices.Clone(system.MarketHistory(1, c.Cycle-1))
+			}
+			idGen := domain.SequentialIdGenerator(s.NextOrderId)
+			system, err = domain.RestoreSystem(&idGen, s.Config, domain.NewConsumers(s.Config.Consumers, s.Seed), events, s.System)
+		case CommandProducing:
+			err = system.ProducingAgentAction(domain.ProducerId(c.AgentId), lo.FromPtr(c.Producing))
+		case CommandOrdering:
+			err = system.OrderingAgentAction(domain.OrderingAgentId(c.AgentId), lo.FromPtr(c.Ordering))
+		case CommandOrderingPass:
+			err = system.OrderingAgentPass(domain.OrderingAgentId(c.AgentId))
+		case CommandStartOrdering:
+			err = system.StartOrdering()
+		case CommandCompleteCycle:
+			var result domain.CycleResult
+			if result, err = system.CompleteCycle(); err == nil {
+				if c.Result != nil && !sameResult(result, *c.Result) {
+					err = fmt.Errorf("%w: cycle %d scored %d, recorded %d", ErrDiverged, c.Cycle, result.Score, c.Result.Score)
+				} else {
+					results = append(results, result)
+				}
+			}
+		default:
+			err = errors.New("unknown command")
+		}
+		if err != nil {
+			return system, results, fmt.Errorf("command [%d] %s: %w", c.Seq, c.Kind, err)
+		}
+	}
+	return system, results, nil
+}
+
+// sameResult compares the results, an empty map of the recorded JSON equals a missing one
+func sameResult(a, b domain.CycleResult) bool {
+	return a.Score == b.Score && maps.Equal(a.AgentScores, b.AgentScores) &&
+		maps.Equal(a.Completed, b.Completed) && maps.Equal(a.Rejected, b.Rejected)
+}
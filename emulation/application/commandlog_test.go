@@ -0,0 +1,148 @@
+package application
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"emulation/domain"
+	"emulation/strategy"
+)
+
+func TestCommandLog(t *testing.T) {
+	// playedEmulator plays cycles with an ordering bot and a producing agent commanded by hand
+	playedEmulator := func(t *testing.T, cycles int) (*Emulator, []domain.CycleResult) {
+		config := testConfig()
+		config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{
+			"c1": {Name: strategy.ProportionalToCapacityName},
+		}
+		e, err := NewEmulator(config, 3)
+		require.NoError(t, err)
+		var results []domain.CycleResult
+		for i := range cycles {
+			require.NoError(t, e.ProducingAgentAction("p1", domain.ProducingAgentCommand{DoRestoration: i == 0}))
+			require.NoError(t, e.StartOrdering())
+			result, err := e.CompleteCycle()
+			require.NoError(t, err)
+			results = append(results, result)
+		}
+		return e, results
+	}
+
+	t.Run(`Given an emulator played by an agent and a bot
+		When its command log is written as JSON lines and replayed
+		Then the replay reproduces the same cycle results and market history`, func(t *testing.T) {
+
+		e, results := playedEmulator(t, 4)
+		defer e.Close()
+		var out bytes.Buffer
+		require.NoError(t, e.RecordCommands(&out))
+		commands, err := ReadCommands(&out)
+		require.NoError(t, err)
+		require.Equal(t, CommandReset, commands[0].Kind)
+		require.Equal(t, uint64(len(commands)), commands[len(commands)-1].Seq)
+
+		system, replayed, err := Replay(commands, 0, nil)
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(results, replayed))
+		require.Empty(t, cmp.Diff(e.GetMarketHistory(0, 10), system.MarketHistory(0, 10)))
+		require.Equal(t, uint(e.GetSystemInfo().CycleCounter), system.GetSystemInfo().CycleCounter)
+	})
+
+	t.Run(`Given a command log
+		When it is replayed up to a cycle
+		Then the system is rebuilt at the start of that cycle`, func(t *testing.T) {
+
+		e, results := playedEmulator(t, 4)
+		defer e.Close()
+
+		system, replayed, err := Replay(e.Commands(0), 3, nil)
+		require.NoError(t, err)
+		require.Equal(t, results[:2], replayed)
+		require.Equal(t, domain.SystemInfo{CycleCounter: 3, State: domain.SystemStateOrdersPlacement}, system.GetSystemInfo())
+		require.Empty(t, cmp.Diff(e.GetMarketHistory(1, 2), system.MarketHistory(0, 10)))
+	})
+
+	t.Run(`Given a command log with a tampered cycle result
+		When it is replayed
+		Then the replay reports the divergence`, func(t *testing.T) {
+
+		e, _ := playedEmulator(t, 2)
+		defer e.Close()
+		commands := e.Commands(0)
+		for i, c := range commands {
+			if c.Kind == CommandCompleteCycle {
+				commands[i].Result = &domain.CycleResult{Score: c.Result.Score + 1}
+				break
+			}
+		}
+
+		_, _, err := Replay(commands, 0, nil)
+		require.ErrorIs(t, err, ErrDiverged)
+	})
+
+	t.Run(`Given an emulator restored from a snapshot
+		When its command log is replayed
+		Then the replay starts from the snapshot`, func(t *testing.T) {
+
+		saved, _ := playedEmulator(t, 2)
+		defer saved.Close()
+		snapshot, err := saved.Snapshot()
+		require.NoError(t, err)
+		e, err := NewEmulator(testConfig(), 0)
+		require.NoError(t, err)
+		defer e.Close()
+		require.NoError(t, e.Restore(snapshot))
+		require.NoError(t, e.StartOrdering())
+		result, err := e.CompleteCycle()
+		require.NoError(t, err)
+
+		commands := e.Commands(2)
+		require.Equal(t, CommandRestore, commands[0].Kind)
+		_, replayed, err := Replay(commands, 0, nil)
+		require.NoError(t, err)
+		require.Equal(t, []domain.CycleResult{result}, replayed)
+	})
+	t.Run(`Given an emulator rewound to a checkpoint and played on
+		When its command log is replayed
+		Then the rewind is recorded without the market history
+		And the replay rebuilds the history from the rewound game`, func(t *testing.T) {
+
+		e, _ := playedEmulator(t, 4)
+		defer e.Close()
+		require.NoError(t, e.Rewind(2))
+		require.NoError(t, e.StartOrdering())
+		result, err := e.CompleteCycle()
+		require.NoError(t, err)
+
+		commands := e.Commands(0)
+		rewind, ok := lo.Find(commands, func(c Command) bool { return c.Kind == CommandRewind })
+		require.True(t, ok)
+		require.Equal(t, uint(2), rewind.Cycle)
+		require.Empty(t, rewind.Snapshot.System.MarketHistory)
+		system, replayed, err := Replay(commands, 0, nil)
+		require.NoError(t, err)
+		require.Equal(t, result, replayed[len(replayed)-1])
+		require.Empty(t, cmp.Diff(e.GetMarketHistory(0, 10), system.MarketHistory(0, 10)))
+	})
+
+	t.Run(`Given an emulator played and reset
+		When its command log is requested
+		Then only the commands of the new game are kept and their numbers go on`, func(t *testing.T) {
+
+		e, _ := playedEmulator(t, 2)
+		defer e.Close()
+		last := e.Commands(0)
+		require.NoError(t, e.Reset())
+
+		commands := e.Commands(0)
+		require.Len(t, commands, 1)
+		require.Equal(t, CommandReset, commands[0].Kind)
+		require.Equal(t, last[len(last)-1].Seq+1, commands[0].Seq)
+		require.Equal(t, commands, e.Commands(commands[0].Seq))
+		require.Empty(t, e.Commands(commands[0].Seq+1))
+	})
+}
@@ -0,0 +1,133 @@
+package application
+
+import (
+	"bytes"
+	"emulation/domain"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ConfigVersion is the schema version of the configuration files written by the emulator
+const ConfigVersion = 2
+
+// configFile is the configuration with its schema version, the files without a version are of version 1
+type configFile struct {
+	Version uint `json:"version"`
+	*domain.Configuration
+}
+
+// migrations upgrade the decoded configuration of a version to the next version
+var migrations = map[uint]func(config map[string]any) error{
+	1: migrateV1,
+}
+
+// migrateV1 renames the investment keys of the producers. Version 1 was decoded without the explicit names,
+// so the restoration and the upgrade products were given as "product" and the upgrade capacity as "capacity".
+func migrateV1(config map[string]any) error {
+	producers, _ := lookup(config, "producerConfigs").([]any)
+	for i, p := range producers {
+		producer, ok := p.(map[string]any)
+		if !ok {
+			return fmt.Errorf("producer config %d is not an object", i)
+		}
+		for key, renames := range map[string]map[string]string{
+			"restoration": {"product": "require"},
+			"upgrade":     {"product": "require", "capacity": "increases"},
+		} {
+			investment, ok := lookup(producer, key).(map[string]any)
+			if !ok {
+				continue
+			}
+			for from, to := range renames {
+				for k, v := range investment {
+					if strings.EqualFold(k, from) {
+						delete(investment, k)
+						investment[to] = v
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// lookup finds the value of the key case-insensitively, as the version 1 decoding did
+func lookup(object map[string]any, key string) any {
+	for k, v := range object {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return nil
+}
+
+// ParseConfig decodes and validates the configuration of any supported version, the older versions are migrated.
+// The decoding is strict: unknown fields and trailing data are errors.
+func ParseConfig(data []byte) (*domain.Configuration, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var config map[string]any
+	if err := dec.Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if dec.Decode(&struct{}{}) != io.EOF {
+		return nil, errors.New("failed to parse config: unexpected data after the configuration")
+	}
+
+	version := uint(1)
+	if v, ok := config["version"]; ok {
+		number, _ := v.(json.Number)
+		n, err := number.Int64()
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid config version %v", v)
+		}
+		version = uint(n)
+	}
+	if version > ConfigVersion {
+		return nil, fmt.Errorf("config version %d is not supported, the latest version is %d", version, ConfigVersion)
+	}
+	for ; version < ConfigVersion; version++ {
+		if err := migrations[version](config); err != nil {
+			return nil, fmt.Errorf("failed to migrate config from version %d: %w", version, err)
+		}
+	}
+	config["version"] = ConfigVersion
+
+	migrated, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate config: %w", err)
+	}
+	file := configFile{Configuration: &domain.Configuration{}}
+	strict := json.NewDecoder(bytes.NewReader(migrated))
+	strict.DisallowUnknownFields()
+	if err := strict.Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	if err := file.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return file.Configuration, nil
+}
+
+// MarshalConfig encodes the configuration as an indented JSON of the current version
+func MarshalConfig(config *domain.Configuration) ([]byte, error) {
+	return json.MarshalIndent(configFile{ConfigVersion, config}, "", "  ")
+}
+
+// LoadConfig reads and validates the configuration from a JSON file
+func LoadConfig(path string) (*domain.Configuration, error) {
+	configData, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	config, err := ParseConfig(configData)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
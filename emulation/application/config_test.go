@@ -0,0 +1,71 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"emulation/domain"
+)
+
+func TestParseConfig(t *testing.T) {
+	config := testConfig()
+	config.ProcessSheets = append(config.ProcessSheets, domain.ProcessSheet{
+		Product: 2, Require: map[domain.CapacityType]domain.Capacity{"1": 5},
+	})
+	config.ProducerConfigs[0].Upgrade = domain.Upgrade{Require: 2, Increases: 30}
+
+	t.Run(`Given a configuration written by the emulator
+		When it is parsed
+		Then it is the same configuration`, func(t *testing.T) {
+
+		data, err := MarshalConfig(config)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"version": 2`)
+
+		parsed, err := ParseConfig(data)
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(config, parsed))
+	})
+
+	t.Run(`Given configurations of version 1 with the investment products as "product" and as "Require"
+		When they are parsed
+		Then they are migrated with the investments in full`, func(t *testing.T) {
+
+		for _, c := range []struct{ producers, investments string }{
+			{"producerConfigs", `"restoration": {"product": 1, "restores": 10}, "upgrade": {"product": 2, "capacity": 30}`},
+			{"producerConfigs", `"Restoration": {"Require": 1, "Restores": 10}, "Upgrade": {"Require": 2, "Increases": 30}`},
+			{"ProducerConfigs", `"restoration": {"product": 1, "restores": 10}, "upgrade": {"product": 2, "capacity": 30}`},
+		} {
+			parsed, err := ParseConfig([]byte(`{
+				"cycleEmission": 100,
+				"processSheets": [{"product": 1, "require": {"1": 10}}, {"product": 2, "require": {"1": 5}}],
+				"` + c.producers + `": [{"id": "p1", "type": "1", "capacity": 100, "degradation": 10, ` + c.investments + `}],
+				"consumers": [{"id": "c1", "products": [1]}]
+			}`))
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(config, parsed))
+		}
+	})
+
+	t.Run(`Given mis-typed configurations
+		When they are parsed
+		Then they are rejected`, func(t *testing.T) {
+
+		for _, c := range []struct{ data, err string }{
+			{`{"version": 2, "cycleEmision": 100}`, `unknown field "cycleEmision"`},
+			{`{"version": 2, "producerConfigs": [{"id": "p1", "restoration": {"product": 1}}]}`, `unknown field "product"`},
+			{`{"version": 3}`, "version 3 is not supported"},
+			{`{"version": "2"}`, "invalid config version"},
+			{`{"version": 2} {}`, "unexpected data"},
+			{`{"version": 2, "cycleEmission": 100,
+				"processSheets": [{"product": 1, "require": {"1": 10}}],
+				"producerConfigs": [{"id": "p1", "type": "1", "capacity": 100, "restoration": {"require": 7, "restores": 10}}]}`,
+				"restoration requires product 7"},
+		} {
+			_, err := ParseConfig([]byte(c.data))
+			require.ErrorContains(t, err, c.err)
+		}
+	})
+}
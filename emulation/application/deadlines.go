@@ -0,0 +1,128 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"emulation/domain"
+	"emulation/strategy"
+)
+
+// MissedDeadlines counts the phase deadlines missed by every agent
+type MissedDeadlines struct {
+	Producing map[domain.ProducerId]uint      `json:"producing,omitempty"`
+	Ordering  map[domain.OrderingAgentId]uint `json:"ordering,omitempty"`
+}
+
+// agents is the part of domain.System the default actions command
+type agents interface {
+	strategy.System
+	PendingProducingAgents() []domain.ProducerId
+	PendingOrderingAgents() []domain.OrderingAgentId
+}
+
+// defaults takes the configured default actions for the agents pending at the end of a phase
+// and remembers the last commands of the agents to repeat them
+type defaults struct {
+	config        domain.Deadlines
+	producing     map[domain.ProducerId]strategy.ProducingStrategy
+	ordering      map[domain.OrderingAgentId]strategy.OrderingStrategy
+	lastProducing map[domain.ProducerId]domain.ProducingAgentCommand
+	lastOrdering  map[domain.OrderingAgentId]domain.OrderingAgentCommand
+	missed        MissedDeadlines
+}
+
+func newDefaults(config domain.Deadlines) (*defaults, error) {
+	if s := config.DefaultProducing.Strategy; config.DefaultProducing.Kind == domain.DefaultActionStrategy {
+		if s == nil {
+			return nil, errors.New("default producing action has no strategy")
+		}
+		if _, err := strategy.NewProducingStrategy(*s); err != nil {
+			return nil, fmt.Errorf("default producing action: %w", err)
+		}
+	}
+	if s := config.DefaultOrdering.Strategy; config.DefaultOrdering.Kind == domain.DefaultActionStrategy {
+		if s == nil {
+			return nil, errors.New("default ordering action has no strategy")
+		}
+		if _, err := strategy.NewOrderingStrategy(*s); err != nil {
+			return nil, fmt.Errorf("default ordering action: %w", err)
+		}
+	}
+	return &defaults{
+		config:        config,
+		producing:     map[domain.ProducerId]strategy.ProducingStrategy{},
+		ordering:      map[domain.OrderingAgentId]strategy.OrderingStrategy{},
+		lastProducing: map[domain.ProducerId]domain.ProducingAgentCommand{},
+		lastOrdering:  map[domain.OrderingAgentId]domain.OrderingAgentCommand{},
+		missed:        MissedDeadlines{map[domain.ProducerId]uint{}, map[domain.OrderingAgentId]uint{}},
+	}, nil
+}
+
+func (d *defaults) producingCommanded(id domain.ProducerId, cmd domain.ProducingAgentCommand) {
+	d.lastProducing[id] = cmd
+}
+
+func (d *defaults) orderingCommanded(id domain.OrderingAgentId, cmd domain.OrderingAgentCommand) {
+	d.lastOrdering[id] = cmd
+}
+
+// invest counts the missed deadline and takes the default action of every pending producing agent.
+// A failing action doesn't stop the others, all errors are returned joined.
+func (d *defaults) invest(s agents) error {
+	var errs []error
+	for _, id := range s.PendingProducingAgents() {
+		d.missed.Producing[id]++
+		var st strategy.ProducingStrategy
+		switch d.config.DefaultProducing.Kind {
+		case domain.DefaultActionRepeat:
+			st = strategy.RepeatProducing{Last: d.lastProducing[id]}
+		case domain.DefaultActionStrategy:
+			if st = d.producing[id]; st == nil {
+				// the strategy is checked by newDefaults
+				st, _ = strategy.NewProducingStrategy(*d.config.DefaultProducing.Strategy)
+				d.producing[id] = st
+			}
+		default:
+			continue
+		}
+		view, err := s.ProducingAgentView(id)
+		if err == nil {
+			err = s.ProducingAgentAction(id, st.Command(view))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("default producing action [%s]: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// order counts the missed deadline and takes the default action of every pending ordering agent.
+// A failing action doesn't stop the others, all errors are returned joined.
+func (d *defaults) order(s agents) error {
+	var errs []error
+	for _, id := range s.PendingOrderingAgents() {
+		d.missed.Ordering[id]++
+		var st strategy.OrderingStrategy
+		switch d.config.DefaultOrdering.Kind {
+		case domain.DefaultActionRepeat:
+			st = strategy.RepeatOrdering{Last: d.lastOrdering[id]}
+		case domain.DefaultActionStrategy:
+			if st = d.ordering[id]; st == nil {
+				// the strategy is checked by newDefaults
+				st, _ = strategy.NewOrderingStrategy(*d.config.DefaultOrdering.Strategy)
+				d.ordering[id] = st
+			}
+		default:
+			continue
+		}
+		view, err := s.OrderingAgentView(id)
+		if err == nil {
+			err = s.OrderingAgentAction(id, st.Command(view))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("default ordering action [%s]: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,138 @@
+package application
+
+import (
+	"strconv"
+	"testing"
+
+	"emulation/domain"
+	"emulation/strategy"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testConsumer struct {
+	id     domain.ConsumerId
+	tokens domain.Tokens
+}
+
+func (t *testConsumer) Emit(val domain.Tokens) {
+	t.tokens = val
+}
+
+func (t *testConsumer) Id() domain.ConsumerId {
+	return t.id
+}
+
+func (t *testConsumer) Order() []domain.ConsumerRequest {
+	return []domain.ConsumerRequest{{ConsumerId: t.id, Product: 1, Tokens: t.tokens}}
+}
+
+type testIdGenerator struct {
+	val int
+}
+
+func (t *testIdGenerator) New() domain.OrderId {
+	id := domain.OrderId(strconv.Itoa(t.val))
+	t.val++
+	return id
+}
+
+func testConfig() *domain.Configuration {
+	return &domain.Configuration{
+		CycleEmission: 100,
+		ProcessSheets: []domain.ProcessSheet{
+			{Product: 1, Require: map[domain.CapacityType]domain.Capacity{"1": 10}},
+		},
+		ProducerConfigs: []domain.ProducingAgentConfig{
+			{Id: "p1", Type: "1", Capacity: 100, Degradation: 10, Restoration: domain.Restoration{Require: 1, Restores: 10}},
+		},
+		Consumers: []domain.ConsumerConfig{
+			{Id: "c1", Products: []domain.Product{1}},
+		},
+	}
+}
+
+func testSystem() *domain.System {
+	return domain.NewSystem(&testIdGenerator{}, testConfig(), map[domain.ConsumerId]domain.Consumer{"c1": &testConsumer{id: "c1"}}, nil)
+}
+
+func TestDefaults(t *testing.T) {
+	t.Run(`Given idle agents and abstain as the default action
+		When the phases end
+		Then the missed deadlines are counted and nothing is commanded`, func(t *testing.T) {
+
+		d, err := newDefaults(domain.Deadlines{})
+		require.NoError(t, err)
+		system := testSystem()
+
+		require.NoError(t, d.invest(system))
+		require.Equal(t, []domain.ProducerId{"p1"}, system.PendingProducingAgents())
+		require.NoError(t, system.StartOrdering())
+		require.NoError(t, d.order(system))
+		require.Equal(t, []domain.OrderingAgentId{"c1"}, system.PendingOrderingAgents())
+		require.Equal(t, MissedDeadlines{
+			Producing: map[domain.ProducerId]uint{"p1": 1},
+			Ordering:  map[domain.OrderingAgentId]uint{"c1": 1},
+		}, d.missed)
+	})
+
+	t.Run(`Given idle agents and a strategy as the default action
+		When the phases end
+		Then the strategy commands for them`, func(t *testing.T) {
+
+		d, err := newDefaults(domain.Deadlines{
+			DefaultOrdering: domain.DefaultAction{
+				Kind:     domain.DefaultActionStrategy,
+				Strategy: &domain.StrategyConfig{Name: strategy.ProportionalToCapacityName},
+			},
+		})
+		require.NoError(t, err)
+		system := testSystem()
+
+		require.NoError(t, system.StartOrdering())
+		require.NoError(t, d.order(system))
+		require.Empty(t, system.PendingOrderingAgents())
+		result, err := system.CompleteCycle()
+		require.NoError(t, err)
+		require.Equal(t, uint(1), result.Completed["c1"])
+	})
+
+	t.Run(`Given an agent which commanded before and repeat as the default action
+		When it misses the deadline
+		Then its last command is repeated`, func(t *testing.T) {
+
+		d, err := newDefaults(domain.Deadlines{
+			DefaultProducing: domain.DefaultAction{Kind: domain.DefaultActionRepeat},
+			DefaultOrdering:  domain.DefaultAction{Kind: domain.DefaultActionRepeat},
+		})
+		require.NoError(t, err)
+		system := testSystem()
+
+		restore := domain.ProducingAgentCommand{DoRestoration: true}
+		require.NoError(t, system.ProducingAgentAction("p1", restore))
+		d.producingCommanded("p1", restore)
+		require.NoError(t, d.invest(system))
+		require.NoError(t, system.StartOrdering())
+		require.NoError(t, d.order(system))
+		require.Empty(t, system.PendingOrderingAgents())
+		_, err = system.CompleteCycle()
+		require.NoError(t, err)
+
+		require.NoError(t, d.invest(system))
+		require.Empty(t, system.PendingProducingAgents())
+		require.Equal(t, uint(1), d.missed.Producing["p1"])
+	})
+
+	t.Run(`Given an invalid default strategy
+		When the defaults are created
+		Then an error is returned`, func(t *testing.T) {
+
+		_, err := newDefaults(domain.Deadlines{
+			DefaultProducing: domain.DefaultAction{
+				Kind:     domain.DefaultActionStrategy,
+				Strategy: &domain.StrategyConfig{Name: "unknown"},
+			},
+		})
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
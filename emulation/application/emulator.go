@@ -1,66 +1,198 @@
 package application
 
 import (
+	"context"
 	"emulation/domain"
+	"emulation/gym"
 	"emulation/models"
-	"encoding/json"
-	"log"
+	"emulation/strategy"
+	"fmt"
 	"log/slog"
-	"os"
-	"strconv"
+	"maps"
 	"sync"
+	"time"
 )
 
-type sequentialGenerator uint64
-
-func (s *sequentialGenerator) New() domain.OrderId {
-	id := domain.OrderId(strconv.Itoa(int(*s)))
-	(*s)++
-	return id
-}
-
 type Emulator struct {
-	idGen  sequentialGenerator
+	idGen  domain.SequentialIdGenerator
 	rwMu   *sync.RWMutex
 	system *domain.System
 	config *domain.Configuration
+	// seed of the consumers' demand
+	seed uint64
+	bots *strategy.Bots
+	env  *gym.Env
+	// defaults are taken for the agents missing the phase deadlines
+	defaults *defaults
+	deadline *time.Timer
+	// phase is incremented on every phase change, so a stale deadline is ignored
+	phase uint
+	// changed is closed on the phase change to wake up the waiting agents
+	changed chan struct{}
+	// played tells whether an agent has acted in the current cycle
+	played bool
+	events *broker
+	// domainEvents are the typed state changes of the system, logged by default
+	domainEvents *domain.EventBus
+	// commands are the accepted commands since the start, replayable by Replay
+	commands commandLog
+	// checkpoints are the states at the start of the cycles of the current game, without the market history
+	checkpoints map[uint]Snapshot
+	// recorder saves the runs to the store, nil unless persisted
+	recorder *runRecorder
+	// placed are the incoming orders of the ordering agents collected for the stream until the ordering phase starts
+	placed map[domain.OrderingAgentId]OrdersCreated
 }
 
-func NewEmulator() *Emulator {
-	slog.Info("emulator.initializing")
-	e := &Emulator{0, &sync.RWMutex{}, nil, nil}
-	e.Reset()
-	return e
+func NewEmulator(config *domain.Configuration, seed uint64) (*Emulator, error) {
+	slog.Info("emulator.initializing",
+		slog.Uint64("seed", seed))
+	e := &Emulator{0, &sync.RWMutex{}, nil, config, seed, nil, nil, nil, nil, 0, make(chan struct{}), false, newBroker(), newEventBus(), commandLog{}, nil, nil, nil}
+	// the stream subscribers and the store get the events of the system from its domain events
+	e.domainEvents.Subscribe(e.streamEvent)
+	if err := e.Reset(); err != nil {
+		return nil, err
+	}
+	return e, nil
 }
 
-func (e *Emulator) Reset() {
+// Reset starts the simulation over with the current configuration.
+// The state is kept when the configuration is invalid.
+func (e *Emulator) Reset() error {
 	e.rwMu.Lock()
 	defer e.rwMu.Unlock()
+	return e.reset()
+}
 
+func (e *Emulator) reset() error {
 	slog.Info("emulator.reset.started")
 
-	// Load configuration from JSON file
-	configData, err := os.ReadFile("config.json")
+	config := e.config
+	bots, err := strategy.NewBots(config)
 	if err != nil {
-		log.Fatalf("Failed to read config file: %v", err)
+		slog.Error("emulator.reset.failed",
+			slog.String("error", err.Error()))
+		return fmt.Errorf("invalid bots configuration: %w", err)
 	}
-
-	var config domain.Configuration
-	if err := json.Unmarshal(configData, &config); err != nil {
-		log.Fatalf("Failed to parse config file: %v", err)
+	defaults, err := newDefaults(config.Deadlines)
+	if err != nil {
+		slog.Error("emulator.reset.failed",
+			slog.String("error", err.Error()))
+		return fmt.Errorf("invalid deadlines configuration: %w", err)
 	}
 
-	if err := config.Validate(); err != nil {
-		log.Fatalf("Invalid configuration: %v", err)
-	}
+	e.idGen = 0
+	e.system = domain.NewSystem(&e.idGen, config, domain.NewConsumers(config.Consumers, e.seed), e.domainEvents)
+	e.bots = bots
+	e.defaults = defaults
+	e.played = false
+	e.record(Command{Kind: CommandReset, Config: config, Seed: e.seed})
+	e.startRun()
 
-	e.system = domain.NewSystem(&e.idGen, &config, map[domain.ConsumerId]domain.Consumer{})
-	e.config = &config
+	if err := e.bots.Invest(e.agents()); err != nil {
+		slog.Error("emulator.reset.bots_failed",
+			slog.String("error", err.Error()))
+	}
+	e.checkpoints = map[uint]Snapshot{}
+	e.checkpoint()
+	e.phaseStarted()
+	e.advance()
 
 	slog.Info("emulator.reset.completed",
 		slog.Int("cycleEmission", int(config.CycleEmission)),
 		slog.Int("processSheets", len(config.ProcessSheets)),
-		slog.Int("producers", len(config.ProducerConfigs)))
+		slog.Int("producers", len(config.ProducerConfigs)),
+		slog.Int("consumers", len(config.Consumers)),
+		slog.Int("orderingBots", len(config.OrderingBots)),
+		slog.Int("producingBots", len(config.ProducingBots)))
+	return nil
+}
+
+// Close stops the phase deadline timer and ends the event subscriptions
+func (e *Emulator) Close() {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+	e.phase++
+	if e.deadline != nil {
+		e.deadline.Stop()
+		e.deadline = nil
+	}
+	e.flushEvents()
+	e.events.close()
+}
+
+// newEventBus is a bus of the domain events logged by default
+func newEventBus() *domain.EventBus {
+	bus := domain.NewEventBus()
+	bus.Subscribe(domain.LogEvents(slog.Default()))
+	return bus
+}
+
+// DomainEvents is the bus of the typed domain events of the emulated system, e.g. for metrics or persistence.
+// The gym episodes publish on their own bus.
+// The handlers are called under the emulator lock and must not call the emulator.
+func (e *Emulator) DomainEvents() *domain.EventBus {
+	return e.domainEvents
+}
+
+// Subscribe streams the events of the system, the agent events are filtered by the agent ids unless they are empty.
+// The channel is closed on unsubscribing, on the session close or when the subscriber lags behind.
+func (e *Emulator) Subscribe(agentIds []string) (<-chan Event, func()) {
+	return e.events.subscribe(agentIds)
+}
+
+// publishing commands the system and records the accepted commands
+type publishing struct {
+	*domain.System
+	e *Emulator
+}
+
+func (p publishing) OrderingAgentAction(id domain.OrderingAgentId, cmd domain.OrderingAgentCommand) error {
+	if err := p.System.OrderingAgentAction(id, cmd); err != nil {
+		return err
+	}
+	p.e.record(Command{Kind: CommandOrdering, AgentId: string(id), Ordering: &cmd})
+	return nil
+}
+
+func (p publishing) ProducingAgentAction(id domain.ProducerId, cmd domain.ProducingAgentCommand) error {
+	if err := p.System.ProducingAgentAction(id, cmd); err != nil {
+		return err
+	}
+	p.e.record(Command{Kind: CommandProducing, AgentId: string(id), Producing: &cmd})
+	return nil
+}
+
+func (p publishing) OrderingAgentPass(id domain.OrderingAgentId) error {
+	if err := p.System.OrderingAgentPass(id); err != nil {
+		return err
+	}
+	p.e.record(Command{Kind: CommandOrderingPass, AgentId: string(id)})
+	return nil
+}
+
+func (e *Emulator) agents() publishing {
+	return publishing{e.system, e}
+}
+
+func (e *Emulator) publish(eventType, agentId string, data any) {
+	e.emit(Event{
+		Type:    eventType,
+		Cycle:   e.system.GetSystemInfo().CycleCounter,
+		AgentId: agentId,
+		Data:    data,
+	})
+}
+
+// emit publishes the event to the subscribers and the store
+func (e *Emulator) emit(event Event) {
+	e.storeEvent(e.events.publish(event))
+}
+
+func (e *Emulator) Seed() uint64 {
+	e.rwMu.RLock()
+	defer e.rwMu.RUnlock()
+	return e.seed
 }
 
 func (e *Emulator) GetOrderingAgentView(id domain.OrderingAgentId) (domain.OrderingAgentView, error) {
@@ -108,15 +240,40 @@ func (e *Emulator) OrderingAgentAction(id domain.OrderingAgentId, cmd domain.Ord
 		slog.String("agentId", string(id)),
 		slog.Int("orders", len(cmd.Orders)))
 
-	if err := e.system.OrderingAgentAction(id, cmd); err != nil {
+	if err := e.agents().OrderingAgentAction(id, cmd); err != nil {
 		slog.Error("emulator.ordering_agent_action.failed",
 			slog.String("agentId", string(id)),
 			slog.String("error", err.Error()))
 		return err
 	}
 
+	e.defaults.orderingCommanded(id, cmd)
+	e.played = true
+
 	slog.Info("emulator.ordering_agent_action.completed",
 		slog.String("agentId", string(id)))
+
+	e.advance()
+	return nil
+}
+
+// OrderingAgentPass ends the ordering agent's turn without bids
+func (e *Emulator) OrderingAgentPass(id domain.OrderingAgentId) error {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+
+	if err := e.agents().OrderingAgentPass(id); err != nil {
+		slog.Error("emulator.ordering_agent_pass.failed",
+			slog.String("agentId", string(id)),
+			slog.String("error", err.Error()))
+		return err
+	}
+	e.played = true
+
+	slog.Info("emulator.ordering_agent_pass.completed",
+		slog.String("agentId", string(id)))
+
+	e.advance()
 	return nil
 }
 
@@ -129,29 +286,61 @@ func (e *Emulator) ProducingAgentAction(id domain.ProducerId, cmd domain.Produci
 		slog.Bool("doUpgrade", cmd.DoUpgrade),
 		slog.Bool("doRestoration", cmd.DoRestoration))
 
-	if err := e.system.ProducingAgentAction(id, cmd); err != nil {
+	if err := e.agents().ProducingAgentAction(id, cmd); err != nil {
 		slog.Error("emulator.producing_agent_action.failed",
 			slog.String("producerId", string(id)),
 			slog.String("error", err.Error()))
 		return err
 	}
 
+	e.defaults.producingCommanded(id, cmd)
+	e.played = true
+
 	slog.Info("emulator.producing_agent_action.completed",
 		slog.String("producerId", string(id)))
+
+	e.advance()
 	return nil
 }
 
+// ProducingAgentPass ends the producing agent's turn without investments
+func (e *Emulator) ProducingAgentPass(id domain.ProducerId) error {
+	return e.ProducingAgentAction(id, domain.ProducingAgentCommand{})
+}
+
 func (e *Emulator) StartOrdering() error {
 	e.rwMu.Lock()
 	defer e.rwMu.Unlock()
+	if err := e.startOrdering(); err != nil {
+		return err
+	}
+	e.advance()
+	return nil
+}
 
+// startOrdering ends the investment phase, the pending producing agents take the default actions
+func (e *Emulator) startOrdering() error {
 	slog.Info("emulator.start_ordering.started")
 
+	if e.system.GetSystemInfo().State == domain.SystemStateOrdersPlacement {
+		if err := e.defaults.invest(e.agents()); err != nil {
+			slog.Error("emulator.start_ordering.defaults_failed",
+				slog.String("error", err.Error()))
+		}
+	}
+
 	if err := e.system.StartOrdering(); err != nil {
 		slog.Error("emulator.start_ordering.failed",
 			slog.String("error", err.Error()))
 		return err
 	}
+	e.record(Command{Kind: CommandStartOrdering})
+
+	if err := e.bots.Order(e.agents()); err != nil {
+		slog.Error("emulator.start_ordering.bots_failed",
+			slog.String("error", err.Error()))
+	}
+	e.phaseStarted()
 
 	slog.Info("emulator.start_ordering.completed")
 	return nil
@@ -160,36 +349,176 @@ func (e *Emulator) StartOrdering() error {
 func (e *Emulator) CompleteCycle() (domain.CycleResult, error) {
 	e.rwMu.Lock()
 	defer e.rwMu.Unlock()
+	result, err := e.completeCycle()
+	if err != nil {
+		return result, err
+	}
+	e.advance()
+	return result, nil
+}
 
+// completeCycle ends the ordering phase, the pending ordering agents take the default actions
+func (e *Emulator) completeCycle() (domain.CycleResult, error) {
 	slog.Info("emulator.complete_cycle.started")
 
+	if e.system.GetSystemInfo().State == domain.SystemStateOrdering {
+		if err := e.defaults.order(e.agents()); err != nil {
+			slog.Error("emulator.complete_cycle.defaults_failed",
+				slog.String("error", err.Error()))
+		}
+	}
+
+	cycle := e.system.GetSystemInfo().CycleCounter
 	result, err := e.system.CompleteCycle()
 	if err != nil {
 		slog.Error("emulator.complete_cycle.failed",
 			slog.String("error", err.Error()))
 		return result, err
 	}
+	e.commands.append(Command{Kind: CommandCompleteCycle, Cycle: cycle, Result: &result})
+	e.storeCycle(cycle, result)
+
+	e.played = false
+	if err := e.bots.Invest(e.agents()); err != nil {
+		slog.Error("emulator.complete_cycle.bots_failed",
+			slog.String("error", err.Error()))
+	}
+	e.checkpoint()
+	e.phaseStarted()
 
 	slog.Info("emulator.complete_cycle.completed",
 		slog.Int("score", int(result.Score)))
 	return result, nil
 }
 
+// advance moves the system on in the lockstep mode (allSubmitted deadlines) while nobody is pending
+// in the current phase. A cycle is completed only if an agent has acted in it,
+// so a session played by bots alone doesn't spin.
+func (e *Emulator) advance() {
+	for {
+		var err error
+		switch e.system.GetSystemInfo().State {
+		case domain.SystemStateOrdersPlacement:
+			if !e.config.Deadlines.Investment.AllSubmitted || len(e.system.PendingProducingAgents()) > 0 {
+				return
+			}
+			slog.Info("emulator.advance.all_submitted")
+			err = e.startOrdering()
+		case domain.SystemStateOrdering:
+			if !e.config.Deadlines.Ordering.AllSubmitted || len(e.system.PendingOrderingAgents()) > 0 || !e.played {
+				return
+			}
+			slog.Info("emulator.advance.all_submitted")
+			_, err = e.completeCycle()
+		}
+		if err != nil {
+			slog.Error("emulator.advance.failed",
+				slog.String("error", err.Error()))
+			return
+		}
+	}
+}
+
+// phaseStarted arms the wall-clock deadline of the current phase and wakes up the waiting agents
+func (e *Emulator) phaseStarted() {
+	e.phase++
+	close(e.changed)
+	e.changed = make(chan struct{})
+	e.publish(EventPhaseChanged, "", PhaseChanged{e.systemInfo().State})
+	if e.deadline != nil {
+		e.deadline.Stop()
+		e.deadline = nil
+	}
+	deadline := e.config.Deadlines.Investment
+	if e.system.GetSystemInfo().State == domain.SystemStateOrdering {
+		deadline = e.config.Deadlines.Ordering
+	}
+	if deadline.TimeoutMs == 0 {
+		return
+	}
+	phase := e.phase
+	e.deadline = time.AfterFunc(time.Duration(deadline.TimeoutMs)*time.Millisecond, func() {
+		e.deadlineExpired(phase)
+	})
+}
+
+func (e *Emulator) deadlineExpired(phase uint) {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+	if phase != e.phase {
+		return
+	}
+
+	slog.Info("emulator.deadline.expired",
+		slog.Int("cycle", int(e.system.GetSystemInfo().CycleCounter)))
+
+	var err error
+	if e.system.GetSystemInfo().State == domain.SystemStateOrdersPlacement {
+		err = e.startOrdering()
+	} else {
+		_, err = e.completeCycle()
+	}
+	if err != nil {
+		slog.Error("emulator.deadline.failed",
+			slog.String("error", err.Error()))
+		return
+	}
+	e.advance()
+}
+
+// GetMissedDeadlines returns the numbers of the deadlines missed by the agents since the last reset
+func (e *Emulator) GetMissedDeadlines() MissedDeadlines {
+	e.rwMu.RLock()
+	defer e.rwMu.RUnlock()
+	return MissedDeadlines{
+		maps.Clone(e.defaults.missed.Producing),
+		maps.Clone(e.defaults.missed.Ordering),
+	}
+}
+
 func (e *Emulator) GetProducerInfos() map[domain.ProducerId]domain.ProducerInfo {
 	e.rwMu.RLock()
 	defer e.rwMu.RUnlock()
 	return e.system.GetProducerInfos()
 }
 
+func (e *Emulator) GetMarketHistory(fromCycle, toCycle uint) []domain.MarketRecord {
+	e.rwMu.RLock()
+	defer e.rwMu.RUnlock()
+	return e.system.MarketHistory(fromCycle, toCycle)
+}
+
 func (e *Emulator) GetOrderingAgentInfos() map[domain.OrderingAgentId]domain.OrderingAgentInfo {
 	e.rwMu.RLock()
 	defer e.rwMu.RUnlock()
 	return e.system.GetOrderingAgentInfos()
 }
 
+// WaitPhase blocks until the system leaves the known phase or the context is done,
+// the current phase is returned either way
+func (e *Emulator) WaitPhase(ctx context.Context, known models.SystemInfo) models.SystemInfo {
+	for {
+		e.rwMu.RLock()
+		info, changed := e.systemInfo(), e.changed
+		e.rwMu.RUnlock()
+		if info != known {
+			return info
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return info
+		}
+	}
+}
+
 func (e *Emulator) GetSystemInfo() models.SystemInfo {
 	e.rwMu.RLock()
 	defer e.rwMu.RUnlock()
+	return e.systemInfo()
+}
+
+func (e *Emulator) systemInfo() models.SystemInfo {
 	info := e.system.GetSystemInfo()
 	state := "OrdersPlacement"
 	if info.State == domain.SystemStateOrdering {
@@ -207,17 +536,96 @@ func (e *Emulator) GetConfig() *domain.Configuration {
 	return e.config
 }
 
-func (e *Emulator) UpdateConfig(config *domain.Configuration) error {
+// UpdateConfig replaces the configuration, it takes effect on the next reset or at once when applied.
+// An invalid configuration is rejected and the current one is kept.
+func (e *Emulator) UpdateConfig(config *domain.Configuration, apply bool) error {
 	e.rwMu.Lock()
 	defer e.rwMu.Unlock()
 
 	slog.Info("emulator.update_config.started",
 		slog.Int("cycleEmission", int(config.CycleEmission)),
 		slog.Int("processSheets", len(config.ProcessSheets)),
-		slog.Int("producers", len(config.ProducerConfigs)))
+		slog.Int("producers", len(config.ProducerConfigs)),
+		slog.Bool("apply", apply))
 
+	if err := checkConfig(config); err != nil {
+		return err
+	}
 	e.config = config
+	if apply {
+		if err := e.reset(); err != nil {
+			return err
+		}
+	}
 
 	slog.Info("emulator.update_config.completed")
 	return nil
 }
+
+// checkConfig validates the configuration with its bots and deadlines, so that the reset with it doesn't fail
+func checkConfig(config *domain.Configuration) error {
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	if _, err := strategy.NewBots(config); err != nil {
+		return fmt.Errorf("invalid bots configuration: %w", err)
+	}
+	if _, err := newDefaults(config.Deadlines); err != nil {
+		return fmt.Errorf("invalid deadlines configuration: %w", err)
+	}
+	return nil
+}
+
+// ResetGym starts a new episode of the step environment. The environment plays its own system
+// built from the current configuration, the emulated system is not affected.
+func (e *Emulator) ResetGym(seed uint64, cycles uint) (gym.Observation, error) {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+
+	slog.Info("emulator.reset_gym.started",
+		slog.Uint64("seed", seed),
+		slog.Int("cycles", int(cycles)))
+
+	// the episodes publish on their own bus, so the subscribers of the session's events don't count them
+	env, err := gym.NewEnv(e.config, cycles, newEventBus())
+	if err != nil {
+		slog.Error("emulator.reset_gym.failed",
+			slog.String("error", err.Error()))
+		return gym.Observation{}, err
+	}
+	observation, err := env.Reset(seed)
+	if err != nil {
+		slog.Error("emulator.reset_gym.failed",
+			slog.String("error", err.Error()))
+		return observation, err
+	}
+	e.env = env
+
+	slog.Info("emulator.reset_gym.completed",
+		slog.Int("cycle", int(observation.Cycle)))
+	return observation, nil
+}
+
+func (e *Emulator) StepGym(actions gym.Actions) (gym.Step, error) {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+
+	if e.env == nil {
+		return gym.Step{}, gym.ErrNotReset
+	}
+
+	step, err := e.env.Step(actions)
+	if err != nil {
+		slog.Error("emulator.step_gym.failed",
+			slog.String("error", err.Error()))
+		return step, err
+	}
+
+	slog.Info("emulator.step_gym.completed",
+		slog.Int("cycle", int(step.Observation.Cycle)),
+		slog.String("phase", string(step.Observation.Phase)),
+		slog.Int("score", int(step.Score)),
+		slog.Int("rejected", len(step.Rejected)),
+		slog.Bool("done", step.Done))
+	return step, nil
+}
@@ -0,0 +1,159 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emulation/domain"
+	"emulation/gym"
+	"emulation/models"
+	"emulation/strategy"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func lockstepConfig() *domain.Configuration {
+	config := testConfig()
+	config.Deadlines = domain.Deadlines{
+		Investment: domain.PhaseDeadline{AllSubmitted: true},
+		Ordering:   domain.PhaseDeadline{AllSubmitted: true},
+	}
+	return config
+}
+
+func TestLockstep(t *testing.T) {
+	t.Run(`Given the lockstep mode
+		When all agents of a phase act or pass
+		Then the system moves on and the waiting agents are woken up`, func(t *testing.T) {
+
+		e, err := NewEmulator(lockstepConfig(), 1)
+		require.NoError(t, err)
+		investment := models.SystemInfo{CycleCounter: 1, State: "OrdersPlacement"}
+		require.Equal(t, investment, e.GetSystemInfo())
+
+		woken := make(chan models.SystemInfo)
+		go func() {
+			woken <- e.WaitPhase(context.Background(), investment)
+		}()
+		require.NoError(t, e.ProducingAgentPass("p1"))
+		require.Equal(t, models.SystemInfo{CycleCounter: 1, State: "Ordering"}, <-woken)
+
+		require.NoError(t, e.OrderingAgentPass("c1"))
+		require.Equal(t, models.SystemInfo{CycleCounter: 2, State: "OrdersPlacement"}, e.GetSystemInfo())
+	})
+
+	t.Run(`Given the lockstep mode and all agents controlled by bots
+		When the system is reset
+		Then it stops in the ordering phase instead of playing cycles on its own`, func(t *testing.T) {
+
+		config := lockstepConfig()
+		config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{
+			"c1": {Name: strategy.ProportionalToCapacityName},
+		}
+		config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{
+			"p1": {Name: strategy.NeverInvestName},
+		}
+		e, err := NewEmulator(config, 1)
+		require.NoError(t, err)
+		require.Equal(t, models.SystemInfo{CycleCounter: 1, State: "Ordering"}, e.GetSystemInfo())
+	})
+
+	t.Run(`Given no phase change
+		When an agent waits
+		Then the known phase is returned as the context is done`, func(t *testing.T) {
+
+		e, err := NewEmulator(testConfig(), 1)
+		require.NoError(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		known := models.SystemInfo{CycleCounter: 1, State: "OrdersPlacement"}
+		require.Equal(t, known, e.WaitPhase(ctx, known))
+		require.Equal(t, models.SystemInfo{CycleCounter: 1, State: "OrdersPlacement"},
+			e.WaitPhase(ctx, models.SystemInfo{CycleCounter: 0, State: "Ordering"}))
+	})
+}
+
+func TestUpdateConfig(t *testing.T) {
+	// playedConfig is the configuration of the last reset in the command log
+	playedConfig := func(e *Emulator) *domain.Configuration {
+		resets := lo.Filter(e.Commands(0), func(c Command, _ int) bool { return c.Kind == CommandReset })
+		return resets[len(resets)-1].Config
+	}
+
+	t.Run(`Given an emulator played for a cycle
+		When the configuration is updated without applying it
+		Then the simulation goes on until the reset starts it over with the new configuration`, func(t *testing.T) {
+
+		e, err := NewEmulator(testConfig(), 1)
+		require.NoError(t, err)
+		require.NoError(t, e.StartOrdering())
+		_, err = e.CompleteCycle()
+		require.NoError(t, err)
+
+		config := testConfig()
+		config.CycleEmission = 200
+		require.NoError(t, e.UpdateConfig(config, false))
+		require.Equal(t, int64(2), e.GetSystemInfo().CycleCounter)
+		require.Equal(t, domain.Tokens(100), playedConfig(e).CycleEmission)
+
+		require.NoError(t, e.Reset())
+		require.Equal(t, int64(1), e.GetSystemInfo().CycleCounter)
+		require.Equal(t, domain.Tokens(200), playedConfig(e).CycleEmission)
+	})
+
+	t.Run(`Given an emulator played for a cycle
+		When the configuration is updated and applied
+		Then the simulation starts over with it at once`, func(t *testing.T) {
+
+		e, err := NewEmulator(testConfig(), 1)
+		require.NoError(t, err)
+		require.NoError(t, e.StartOrdering())
+		_, err = e.CompleteCycle()
+		require.NoError(t, err)
+
+		config := testConfig()
+		config.CycleEmission = 200
+		require.NoError(t, e.UpdateConfig(config, true))
+		require.Equal(t, int64(1), e.GetSystemInfo().CycleCounter)
+		require.Equal(t, domain.Tokens(200), playedConfig(e).CycleEmission)
+	})
+
+	t.Run(`Given an emulator
+		When the configuration with an unknown bot strategy is updated
+		Then it is rejected and the current configuration is kept`, func(t *testing.T) {
+
+		e, err := NewEmulator(testConfig(), 1)
+		require.NoError(t, err)
+
+		config := testConfig()
+		config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{"c1": {Name: "unknown"}}
+		require.Error(t, e.UpdateConfig(config, false))
+		require.Equal(t, testConfig(), e.GetConfig())
+		require.NoError(t, e.Reset())
+	})
+}
+
+func TestGym(t *testing.T) {
+	t.Run(`Given a subscriber of the session's domain events
+		When a gym episode is played
+		Then it gets none of the episode's events`, func(t *testing.T) {
+
+		e, err := NewEmulator(testConfig(), 1)
+		require.NoError(t, err)
+		var events []domain.Event
+		e.DomainEvents().Subscribe(func(event domain.Event) {
+			events = append(events, event)
+		})
+
+		_, err = e.ResetGym(1, 2)
+		require.NoError(t, err)
+		for done := false; !done; {
+			step, err := e.StepGym(gym.Actions{})
+			require.NoError(t, err)
+			done = step.Done
+		}
+		require.Empty(t, events)
+	})
+}
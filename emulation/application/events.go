@@ -0,0 +1,196 @@
+package application
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/samber/lo"
+
+	"emulation/domain"
+)
+
+// Event types of the stream
+const (
+	EventPhaseChanged        = "phase.changed"
+	EventOrdersCreated       = "orders.created"
+	EventBidPlaced           = "bid.placed"
+	EventInvestmentRequested = "investment.requested"
+	EventInvestmentCompleted = "investment.completed"
+	EventInvestmentRejected  = "investment.rejected"
+	EventProduction          = "production"
+	EventCycleCompleted      = "cycle.completed"
+)
+
+// Event is a change of the emulated system published to the stream subscribers.
+// AgentId is empty for the system-wide events, Data holds the payload of the type.
+type Event struct {
+	Seq     uint64 `json:"seq"`
+	Type    string `json:"type"`
+	Cycle   uint   `json:"cycle"`
+	AgentId string `json:"agentId,omitempty"`
+	Data    any    `json:"data,omitempty"`
+}
+
+type PhaseChanged struct {
+	State string `json:"state"`
+}
+
+// OrdersCreated are the incoming orders of an ordering agent at the start of the ordering phase
+type OrdersCreated struct {
+	Orders map[domain.OrderId]map[domain.CapacityType]domain.Capacity `json:"orders"`
+	Tokens map[domain.OrderId]domain.Tokens                           `json:"tokens"`
+}
+
+// BidPlaced is a bid of an ordering agent for a part of an order
+type BidPlaced struct {
+	OrderId      domain.OrderId      `json:"orderId"`
+	ProducerId   domain.ProducerId   `json:"producerId"`
+	CapacityType domain.CapacityType `json:"capacityType"`
+	Capacity     domain.Capacity     `json:"capacity"`
+	Tokens       domain.Tokens       `json:"tokens"`
+}
+
+// InvestmentRequested is an upgrade or a restoration requested by a producer
+type InvestmentRequested struct {
+	Type     string          `json:"type"`
+	Product  domain.Product  `json:"product"`
+	Capacity domain.Capacity `json:"capacity"`
+}
+
+// InvestmentCompleted is an upgrade or a restoration applied to a producer with its capacities after it
+type InvestmentCompleted struct {
+	Type        string          `json:"type"`
+	Capacity    domain.Capacity `json:"capacity"`
+	MaxCapacity domain.Capacity `json:"maxCapacity"`
+}
+
+// InvestmentRejected is an upgrade or a restoration which order failed, the producer may request it again
+type InvestmentRejected struct {
+	Type string `json:"type"`
+}
+
+// Production is the auction result of a producer in the completed cycle
+type Production struct {
+	CapacityType      domain.CapacityType `json:"capacityType"`
+	CutOffPrice       *float64            `json:"cutOffPrice,omitempty"`
+	AvailableCapacity domain.Capacity     `json:"availableCapacity"`
+	RequestedCapacity domain.Capacity     `json:"requestedCapacity"`
+	AcceptedCapacity  domain.Capacity     `json:"acceptedCapacity"`
+	Funds             domain.Tokens       `json:"funds"`
+}
+
+type CycleCompleted struct {
+	Score     domain.Score                    `json:"score"`
+	Completed map[domain.OrderingAgentId]uint `json:"completed,omitempty"`
+	Rejected  map[domain.OrderingAgentId]uint `json:"rejected,omitempty"`
+}
+
+// streamEvent maps a domain event of the emulated system to the stream events, the other domain events aren't streamed.
+// The incoming orders are collected and sent by the ordering agent when the ordering phase starts.
+func (e *Emulator) streamEvent(event domain.Event) {
+	switch ev := event.(type) {
+	case domain.OrderPlaced:
+		if e.placed == nil {
+			e.placed = map[domain.OrderingAgentId]OrdersCreated{}
+		}
+		orders, ok := e.placed[ev.AgentId]
+		if !ok {
+			orders = OrdersCreated{map[domain.OrderId]map[domain.CapacityType]domain.Capacity{}, map[domain.OrderId]domain.Tokens{}}
+			e.placed[ev.AgentId] = orders
+		}
+		orders.Orders[ev.OrderId] = ev.Required
+		orders.Tokens[ev.OrderId] = ev.Tokens
+	case domain.OrderingStarted:
+		for _, id := range slices.Sorted(maps.Keys(e.placed)) {
+			e.emit(Event{Type: EventOrdersCreated, Cycle: ev.Cycle, AgentId: string(id), Data: e.placed[id]})
+		}
+		e.placed = nil
+	case domain.BidPlaced:
+		e.publish(EventBidPlaced, string(ev.AgentId), BidPlaced{ev.OrderId, ev.ProducerId, ev.CapacityType, ev.Capacity, ev.Tokens})
+	case domain.InvestmentRequested:
+		e.publish(EventInvestmentRequested, string(ev.ProducerId), InvestmentRequested{ev.Type.String(), ev.Product, ev.Capacity})
+	case domain.InvestmentCompleted:
+		e.publish(EventInvestmentCompleted, string(ev.ProducerId), InvestmentCompleted{ev.Type.String(), ev.Capacity, ev.MaxCapacity})
+	case domain.InvestmentRejected:
+		e.publish(EventInvestmentRejected, string(ev.ProducerId), InvestmentRejected{ev.Type.String()})
+	case domain.ProductionCompleted:
+		var cutOffPrice *float64
+		if !ev.CutOffPrice.IsNaN() {
+			cutOffPrice = lo.ToPtr(float64(ev.CutOffPrice))
+		}
+		e.emit(Event{Type: EventProduction, Cycle: ev.Cycle, AgentId: string(ev.ProducerId), Data: Production{
+			ev.CapacityType, cutOffPrice, ev.AvailableCapacity, ev.RequestedCapacity, ev.AcceptedCapacity, ev.Funds,
+		}})
+	case domain.CycleCompleted:
+		e.emit(Event{Type: EventCycleCompleted, Cycle: ev.Cycle, Data: CycleCompleted{ev.Score, ev.Completed, ev.Rejected}})
+	}
+}
+
+// subscriberBuffer is the number of events a subscriber may lag behind before it is dropped
+const subscriberBuffer = 1024
+
+type subscriber struct {
+	c chan Event
+	// agentIds filter the agent events, the system-wide events are always sent
+	agentIds []string
+}
+
+// broker fans the events out to the subscribers without blocking the publisher:
+// a subscriber which doesn't keep up is dropped and its channel is closed
+type broker struct {
+	mu          sync.Mutex
+	seq         uint64
+	subscribers map[*subscriber]struct{}
+}
+
+func newBroker() *broker {
+	return &broker{subscribers: map[*subscriber]struct{}{}}
+}
+
+func (b *broker) publish(event Event) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event.Seq = b.seq
+	for s := range b.subscribers {
+		if event.AgentId != "" && len(s.agentIds) > 0 && !slices.Contains(s.agentIds, event.AgentId) {
+			continue
+		}
+		select {
+		case s.c <- event:
+		default:
+			delete(b.subscribers, s)
+			close(s.c)
+		}
+	}
+	return event
+}
+
+// subscribe returns the events channel and the function to unsubscribe
+func (b *broker) subscribe(agentIds []string) (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := &subscriber{make(chan Event, subscriberBuffer), agentIds}
+	b.subscribers[s] = struct{}{}
+	return s.c, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[s]; ok {
+			delete(b.subscribers, s)
+			close(s.c)
+		}
+	}
+}
+
+// close ends all the subscriptions
+func (b *broker) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for s := range b.subscribers {
+		delete(b.subscribers, s)
+		close(s.c)
+	}
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"testing"
+
+	"emulation/domain"
+	"emulation/strategy"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func drain(events <-chan Event) []Event {
+	var result []Event
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return result
+			}
+			result = append(result, event)
+		default:
+			return result
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	t.Run(`Given a subscriber filtering an agent
+		When a cycle is played
+		Then it receives the system-wide events and the events of the agent in order`, func(t *testing.T) {
+
+		e, err := NewEmulator(testConfig(), 1)
+		require.NoError(t, err)
+		events, unsubscribe := e.Subscribe([]string{"c1"})
+		defer unsubscribe()
+		all, unsubscribeAll := e.Subscribe(nil)
+		defer unsubscribeAll()
+
+		require.NoError(t, e.ProducingAgentAction("p1", domain.ProducingAgentCommand{DoRestoration: true}))
+		require.NoError(t, e.StartOrdering())
+		view, err := e.GetOrderingAgentView("c1")
+		require.NoError(t, err)
+		orders := lo.MapValues(view.Incoming, func(_ map[domain.CapacityType]domain.Capacity, id domain.OrderId) map[domain.ProducerId]domain.Tokens {
+			return map[domain.ProducerId]domain.Tokens{"p1": view.Tokens[id]}
+		})
+		require.NoError(t, e.OrderingAgentAction("c1", domain.OrderingAgentCommand{Orders: orders}))
+		_, err = e.CompleteCycle()
+		require.NoError(t, err)
+
+		types := func(events []Event) []string {
+			return lo.Map(events, func(e Event, _ int) string { return e.Type })
+		}
+		require.Equal(t, []string{
+			EventOrdersCreated, EventPhaseChanged, EventBidPlaced, EventCycleCompleted, EventPhaseChanged,
+		}, types(drain(events)))
+
+		received := drain(all)
+		require.Equal(t, []string{
+			EventInvestmentRequested, EventOrdersCreated, EventOrdersCreated, EventPhaseChanged, EventBidPlaced,
+			EventProduction, EventCycleCompleted, EventPhaseChanged,
+		}, types(received))
+		require.Equal(t, Event{received[0].Seq, EventInvestmentRequested, 1, "p1", InvestmentRequested{"restoration", 1, 10}}, received[0])
+		require.Equal(t, OrdersCreated{view.Incoming, view.Tokens}, received[1].Data)
+		require.Len(t, orders, 1)
+		for id := range orders {
+			require.Equal(t, BidPlaced{id, "p1", "1", 10, view.Tokens[id]}, received[4].Data)
+		}
+		require.Equal(t, CycleCompleted{1, map[domain.OrderingAgentId]uint{"c1": 1}, map[domain.OrderingAgentId]uint{}}, received[6].Data)
+		require.True(t, lo.IsSortedByKey(received, func(e Event) uint64 { return e.Seq }))
+	})
+
+	t.Run(`Given an ordering agent controlled by a bot
+		When the ordering phase starts
+		Then the bot's bids are streamed from the domain events like the agents' ones`, func(t *testing.T) {
+
+		config := testConfig()
+		config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{"c1": {Name: strategy.ProportionalToCapacityName}}
+		e, err := NewEmulator(config, 1)
+		require.NoError(t, err)
+		events, unsubscribe := e.Subscribe([]string{"c1"})
+		defer unsubscribe()
+
+		require.NoError(t, e.StartOrdering())
+		received := drain(events)
+		require.Equal(t, []string{EventOrdersCreated, EventBidPlaced, EventPhaseChanged}, lo.Map(received, func(e Event, _ int) string { return e.Type }))
+		bid := received[1].Data.(BidPlaced)
+		require.Equal(t, received[0].Data.(OrdersCreated).Tokens[bid.OrderId], bid.Tokens)
+	})
+
+	t.Run(`Given producers' restorations
+		When one is produced and another times out
+		Then the producers get the investment completed and rejected events`, func(t *testing.T) {
+
+		investment := func(events []Event) []Event {
+			return lo.Filter(events, func(e Event, _ int) bool {
+				return e.Type == EventInvestmentCompleted || e.Type == EventInvestmentRejected
+			})
+		}
+
+		e, err := NewEmulator(testConfig(), 1)
+		require.NoError(t, err)
+		events, unsubscribe := e.Subscribe([]string{"p1"})
+		defer unsubscribe()
+		require.NoError(t, e.ProducingAgentAction("p1", domain.ProducingAgentCommand{DoRestoration: true}))
+		require.NoError(t, e.StartOrdering())
+		view, err := e.GetOrderingAgentView("p1")
+		require.NoError(t, err)
+		require.Len(t, view.Incoming, 1)
+		for id := range view.Incoming {
+			require.NoError(t, e.OrderingAgentAction("p1", domain.OrderingAgentCommand{
+				Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{id: {"p1": view.Tokens[id]}},
+			}))
+		}
+		_, err = e.CompleteCycle()
+		require.NoError(t, err)
+		require.Equal(t, []Event{{0, EventInvestmentCompleted, 1, "p1", InvestmentCompleted{"restoration", 100, 100}}},
+			lo.Map(investment(drain(events)), func(e Event, _ int) Event { e.Seq = 0; return e }))
+
+		require.NoError(t, e.ProducingAgentAction("p1", domain.ProducingAgentCommand{DoRestoration: true}))
+		for range 3 {
+			require.NoError(t, e.StartOrdering())
+			_, err = e.CompleteCycle()
+			require.NoError(t, err)
+		}
+		require.Equal(t, []Event{{0, EventInvestmentRejected, 4, "p1", InvestmentRejected{"restoration"}}},
+			lo.Map(investment(drain(events)), func(e Event, _ int) Event { e.Seq = 0; return e }))
+	})
+
+	t.Run(`Given a subscriber which doesn't read
+		When it lags behind the buffer
+		Then it is dropped and its channel is closed`, func(t *testing.T) {
+
+		b := newBroker()
+		events, unsubscribe := b.subscribe(nil)
+		for range subscriberBuffer + 1 {
+			b.publish(Event{Type: EventPhaseChanged})
+		}
+		require.Len(t, drain(events), subscriberBuffer)
+		_, ok := <-events
+		require.False(t, ok)
+		require.NotPanics(t, unsubscribe)
+	})
+}
@@ -0,0 +1,202 @@
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"emulation/domain"
+)
+
+// Scenario is a named configuration with the metadata of the intended play. Its name is the file name without the extension.
+type Scenario struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Difficulty is free-form, e.g. easy, medium or hard
+	Difficulty string `json:"difficulty,omitempty"`
+	// Expected describes the behaviour the scenario is meant to show
+	Expected string `json:"expected,omitempty"`
+	// Seed of the consumers' demand, the seed of the session is kept when nil
+	Seed   *uint64               `json:"seed,omitempty"`
+	Config *domain.Configuration `json:"config,omitempty"`
+}
+
+// scenarioFile is the file format, the configuration is decoded by ParseConfig
+type scenarioFile struct {
+	Description string          `json:"description"`
+	Difficulty  string          `json:"difficulty"`
+	Expected    string          `json:"expected"`
+	Seed        *uint64         `json:"seed"`
+	Config      json.RawMessage `json:"config"`
+}
+
+// scenarioExtensions are the supported formats in the order of lookup
+var scenarioExtensions = []string{".json", ".yaml", ".yml"}
+
+// ScenarioLibrary is a directory of scenarios in JSON or YAML. The files are read on every use,
+// so that the scenarios added or edited meanwhile are taken.
+type ScenarioLibrary struct {
+	dir string
+}
+
+func NewScenarioLibrary(dir string) *ScenarioLibrary {
+	return &ScenarioLibrary{dir}
+}
+
+// List returns the valid scenarios sorted by name without their configurations,
+// the invalid files are logged and skipped. A missing directory is an empty library.
+func (l *ScenarioLibrary) List() ([]Scenario, error) {
+	entries, err := os.ReadDir(l.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Scenario{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenarios: %w", err)
+	}
+	result := []Scenario{}
+	for _, entry := range entries {
+		name, ext := scenarioName(entry.Name())
+		if entry.IsDir() || !slices.Contains(scenarioExtensions, ext) {
+			continue
+		}
+		// the same name in several formats is listed once, as Get finds it
+		if slices.ContainsFunc(result, func(s Scenario) bool { return s.Name == name }) {
+			continue
+		}
+		scenario, err := l.Get(name)
+		if err != nil {
+			slog.Error("scenarios.list.invalid",
+				slog.String("file", entry.Name()),
+				slog.String("error", err.Error()))
+			continue
+		}
+		scenario.Config = nil
+		result = append(result, scenario)
+	}
+	slices.SortFunc(result, func(a, b Scenario) int { return strings.Compare(a.Name, b.Name) })
+	return result, nil
+}
+
+// Get reads the scenario, the file is looked up with the extensions in the order .json, .yaml, .yml
+func (l *ScenarioLibrary) Get(name string) (Scenario, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return Scenario{}, fmt.Errorf("scenario %s: %w", name, domain.ErrNotFound)
+	}
+	for _, ext := range scenarioExtensions {
+		data, err := os.ReadFile(filepath.Join(l.dir, name+ext))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return Scenario{}, fmt.Errorf("failed to read scenario %s: %w", name, err)
+		}
+		scenario, err := ParseScenario(data, ext != ".json")
+		if err != nil {
+			return Scenario{}, fmt.Errorf("scenario %s: %w", name, err)
+		}
+		scenario.Name = name
+		return scenario, nil
+	}
+	return Scenario{}, fmt.Errorf("scenario %s: %w", name, domain.ErrNotFound)
+}
+
+func scenarioName(file string) (string, string) {
+	ext := filepath.Ext(file)
+	return strings.TrimSuffix(file, ext), ext
+}
+
+// ParseScenario decodes the scenario strictly, as ParseConfig does with its configuration
+func ParseScenario(data []byte, isYAML bool) (Scenario, error) {
+	if isYAML {
+		var err error
+		if data, err = yamlToJSON(data); err != nil {
+			return Scenario{}, err
+		}
+	}
+	var file scenarioFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return Scenario{}, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+	if dec.Decode(&struct{}{}) != io.EOF {
+		return Scenario{}, errors.New("failed to parse scenario: unexpected data after the scenario")
+	}
+	if len(file.Config) == 0 {
+		return Scenario{}, errors.New("scenario has no config")
+	}
+	config, err := ParseConfig(file.Config)
+	if err != nil {
+		return Scenario{}, err
+	}
+	return Scenario{
+		Description: file.Description,
+		Difficulty:  file.Difficulty,
+		Expected:    file.Expected,
+		Seed:        file.Seed,
+		Config:      config,
+	}, nil
+}
+
+// yamlToJSON converts the YAML document to JSON, the keys of the mappings become strings
+// as the capacity types and the products of the configuration are keys in JSON
+func yamlToJSON(data []byte) ([]byte, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+	var convert func(v any) any
+	convert = func(v any) any {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, item := range v {
+				v[k] = convert(item)
+			}
+			return v
+		case map[any]any:
+			result := make(map[string]any, len(v))
+			for k, item := range v {
+				result[fmt.Sprint(k)] = convert(item)
+			}
+			return result
+		case []any:
+			for i, item := range v {
+				v[i] = convert(item)
+			}
+			return v
+		}
+		return v
+	}
+	return json.Marshal(convert(doc))
+}
+
+// LoadScenario starts the simulation over with the scenario's configuration and seed
+func (e *Emulator) LoadScenario(library *ScenarioLibrary, name string) error {
+	scenario, err := library.Get(name)
+	if err != nil {
+		return err
+	}
+	if err := checkConfig(scenario.Config); err != nil {
+		return err
+	}
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+
+	slog.Info("emulator.load_scenario.started",
+		slog.String("scenario", name))
+
+	e.config = scenario.Config
+	if scenario.Seed != nil {
+		e.seed = *scenario.Seed
+	}
+	return e.reset()
+}
@@ -0,0 +1,108 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"emulation/domain"
+)
+
+func TestScenarioLibrary(t *testing.T) {
+	// testLibrary writes the files to a temporary directory
+	testLibrary := func(t *testing.T, files map[string]string) *ScenarioLibrary {
+		dir := t.TempDir()
+		for name, data := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644))
+		}
+		return NewScenarioLibrary(dir)
+	}
+	const jsonScenario = `{
+		"description": "one producer", "difficulty": "easy", "seed": 7,
+		"config": {"version": 2, "cycleEmission": 100,
+			"processSheets": [{"product": 1, "require": {"1": 10}}],
+			"producerConfigs": [{"id": "p1", "type": "1", "capacity": 100, "degradation": 10, "restoration": {"require": 1, "restores": 10}}],
+			"consumers": [{"id": "c1", "products": [1]}]}
+	}`
+	const yamlScenario = `
+description: one producer in YAML
+expected: the capacity is restored
+config:
+  version: 2
+  cycleEmission: 100
+  processSheets:
+    - product: 1
+      require: {1: 10}
+  producerConfigs:
+    - {id: p1, type: "1", capacity: 100, degradation: 10, restoration: {require: 1, restores: 10}}
+  consumers:
+    - {id: c1, products: [1]}
+`
+
+	t.Run(`Given a library of a JSON and a YAML scenario, an invalid one and a file of another format
+		When the scenarios are listed and read
+		Then the valid ones are listed by name without their configurations and read with them`, func(t *testing.T) {
+
+		library := testLibrary(t, map[string]string{
+			"basic.json":  jsonScenario,
+			"yaml.yml":    yamlScenario,
+			"broken.yaml": "config: {version: 2, cycleEmision: 100}",
+			"notes.txt":   "not a scenario",
+		})
+
+		scenarios, err := library.List()
+		require.NoError(t, err)
+		require.Equal(t, []Scenario{
+			{Name: "basic", Description: "one producer", Difficulty: "easy", Seed: lo.ToPtr(uint64(7))},
+			{Name: "yaml", Description: "one producer in YAML", Expected: "the capacity is restored"},
+		}, scenarios)
+
+		for _, name := range []string{"basic", "yaml"} {
+			scenario, err := library.Get(name)
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(testConfig(), scenario.Config))
+		}
+		_, err = library.Get("broken")
+		require.ErrorContains(t, err, `unknown field "cycleEmision"`)
+	})
+
+	t.Run(`Given a library
+		When an unknown scenario or a path is read
+		Then it is not found`, func(t *testing.T) {
+
+		library := testLibrary(t, map[string]string{"basic.json": jsonScenario})
+		for _, name := range []string{"missing", "", "../basic", ".basic"} {
+			_, err := library.Get(name)
+			require.ErrorIs(t, err, domain.ErrNotFound)
+		}
+
+		scenarios, err := NewScenarioLibrary(filepath.Join(t.TempDir(), "missing")).List()
+		require.NoError(t, err)
+		require.Empty(t, scenarios)
+	})
+
+	t.Run(`Given an emulator played for a cycle
+		When a scenario is loaded
+		Then the simulation starts over with the scenario's configuration and seed`, func(t *testing.T) {
+
+		config := testConfig()
+		config.CycleEmission = 500
+		e, err := NewEmulator(config, 1)
+		require.NoError(t, err)
+		require.NoError(t, e.StartOrdering())
+		_, err = e.CompleteCycle()
+		require.NoError(t, err)
+
+		library := testLibrary(t, map[string]string{"basic.json": jsonScenario})
+		require.NoError(t, e.LoadScenario(library, "basic"))
+		require.Equal(t, int64(1), e.GetSystemInfo().CycleCounter)
+		require.Equal(t, uint64(7), e.Seed())
+		require.Empty(t, cmp.Diff(testConfig(), e.GetConfig()))
+
+		require.ErrorIs(t, e.LoadScenario(library, "missing"), domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,177 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"sync"
+
+	"emulation/domain"
+)
+
+// DefaultSession is created on the server start
+const DefaultSession = "default"
+
+var ErrSessionExists = errors.New("session exists")
+
+// Sessions are independent simulations served by one server
+type Sessions struct {
+	mu       sync.RWMutex
+	sessions map[string]*Emulator
+	// last generated session number
+	last int
+	// store saves the runs of the sessions, nil when they aren't persisted
+	store Store
+}
+
+func NewSessions() *Sessions {
+	return &Sessions{sessions: map[string]*Emulator{}}
+}
+
+// Persist saves the runs of the sessions created from now on to the store
+func (s *Sessions) Persist(store Store) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store = store
+}
+
+// Create starts a session with its own configuration and seed, an empty id is generated
+func (s *Sessions) Create(id string, config *domain.Configuration, seed uint64) (string, *Emulator, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, err := s.newId(id)
+	if err != nil {
+		return "", nil, err
+	}
+	emulator, err := NewEmulator(config, seed)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := s.persist(id, emulator); err != nil {
+		return "", nil, err
+	}
+	s.sessions[id] = emulator
+
+	slog.Info("sessions.created",
+		slog.String("sessionId", id),
+		slog.Uint64("seed", seed))
+	return id, emulator, nil
+}
+
+// Fork starts a session from the checkpoint of the source session's cycle, the source session isn't changed.
+// The branch plays with the given configuration, or with the source's one when it is nil.
+func (s *Sessions) Fork(id, source string, cycle uint, config *domain.Configuration) (string, *Emulator, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from, ok := s.sessions[source]
+	if !ok {
+		return "", nil, fmt.Errorf("session %s: %w", source, domain.ErrNotFound)
+	}
+	snapshot, err := from.Checkpoint(cycle)
+	if err != nil {
+		return "", nil, err
+	}
+	if config != nil {
+		snapshot.Config = config
+	}
+	id, err = s.newId(id)
+	if err != nil {
+		return "", nil, err
+	}
+	emulator, err := NewEmulator(snapshot.Config, snapshot.Seed)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := emulator.Restore(snapshot); err != nil {
+		emulator.Close()
+		return "", nil, err
+	}
+	if err := s.persist(id, emulator); err != nil {
+		return "", nil, err
+	}
+	s.sessions[id] = emulator
+
+	slog.Info("sessions.forked",
+		slog.String("sessionId", id),
+		slog.String("source", source),
+		slog.Int("cycle", int(cycle)))
+	return id, emulator, nil
+}
+
+func (s *Sessions) persist(id string, emulator *Emulator) error {
+	if s.store == nil {
+		return nil
+	}
+	if err := emulator.Persist(s.store, id); err != nil {
+		emulator.Close()
+		return fmt.Errorf("failed to persist session %s: %w", id, err)
+	}
+	return nil
+}
+
+// newId generates the id when it is empty and checks that it is free
+func (s *Sessions) newId(id string) (string, error) {
+	if id == "" {
+		for id == "" || s.sessions[id] != nil {
+			s.last++
+			id = "session-" + strconv.Itoa(s.last)
+		}
+	}
+	if _, ok := s.sessions[id]; ok {
+		return "", fmt.Errorf("%w: %s", ErrSessionExists, id)
+	}
+	return id, nil
+}
+
+func (s *Sessions) Get(id string) (*Emulator, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	emulator, ok := s.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("session %s: %w", id, domain.ErrNotFound)
+	}
+	return emulator, nil
+}
+
+// List returns the sorted session ids
+func (s *Sessions) List() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]string, 0, len(s.sessions))
+	for id := range s.sessions {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (s *Sessions) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	emulator, ok := s.sessions[id]
+	if !ok {
+		return fmt.Errorf("session %s: %w", id, domain.ErrNotFound)
+	}
+	emulator.Close()
+	delete(s.sessions, id)
+
+	slog.Info("sessions.deleted",
+		slog.String("sessionId", id))
+	return nil
+}
+
+// Close closes all sessions, their unsaved events are saved to the store
+func (s *Sessions) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, emulator := range s.sessions {
+		emulator.Close()
+	}
+}
@@ -0,0 +1,60 @@
+package application
+
+import (
+	"testing"
+
+	"emulation/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessions(t *testing.T) {
+	t.Run(`Given two sessions
+		When one of them plays a cycle and the other is reset
+		Then they don't affect each other`, func(t *testing.T) {
+
+		sessions := NewSessions()
+		_, a, err := sessions.Create("a", testConfig(), 1)
+		require.NoError(t, err)
+		id, b, err := sessions.Create("", testConfig(), 2)
+		require.NoError(t, err)
+		require.Equal(t, "session-1", id)
+		require.Equal(t, []string{"a", "session-1"}, sessions.List())
+
+		require.NoError(t, a.StartOrdering())
+		_, err = a.CompleteCycle()
+		require.NoError(t, err)
+		require.NoError(t, b.Reset())
+
+		require.Equal(t, int64(2), a.GetSystemInfo().CycleCounter)
+		require.Equal(t, int64(1), b.GetSystemInfo().CycleCounter)
+		require.Equal(t, uint64(2), b.Seed())
+	})
+
+	t.Run(`Given a session
+		When it is created again or deleted
+		Then it exists only once and is not found after the deletion`, func(t *testing.T) {
+
+		sessions := NewSessions()
+		_, _, err := sessions.Create(DefaultSession, testConfig(), 0)
+		require.NoError(t, err)
+		_, _, err = sessions.Create(DefaultSession, testConfig(), 0)
+		require.ErrorIs(t, err, ErrSessionExists)
+
+		require.NoError(t, sessions.Delete(DefaultSession))
+		_, err = sessions.Get(DefaultSession)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.ErrorIs(t, sessions.Delete(DefaultSession), domain.ErrNotFound)
+		require.Empty(t, sessions.List())
+	})
+
+	t.Run(`Given an invalid bots configuration
+		When a session is created
+		Then an error is returned`, func(t *testing.T) {
+
+		config := testConfig()
+		config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{"c1": {Name: "unknown"}}
+		_, _, err := NewSessions().Create("", config, 0)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
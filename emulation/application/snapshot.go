@@ -0,0 +1,164 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+
+	"emulation/domain"
+	"emulation/strategy"
+)
+
+// Snapshot is the serialisable state of the emulator. The bots start over on the restore,
+// the state of their strategies isn't kept.
+type Snapshot struct {
+	Config        *domain.Configuration                                  `json:"config"`
+	Seed          uint64                                                 `json:"seed"`
+	NextOrderId   uint64                                                 `json:"nextOrderId"`
+	System        domain.Snapshot                                        `json:"system"`
+	Missed        MissedDeadlines                                        `json:"missed"`
+	LastProducing map[domain.ProducerId]domain.ProducingAgentCommand     `json:"lastProducing,omitempty"`
+	LastOrdering  map[domain.OrderingAgentId]domain.OrderingAgentCommand `json:"lastOrdering,omitempty"`
+	// Played tells whether an agent has acted in the current cycle
+	Played bool `json:"played,omitempty"`
+}
+
+// Snapshot saves the state of the emulated system
+func (e *Emulator) Snapshot() (Snapshot, error) {
+	e.rwMu.RLock()
+	defer e.rwMu.RUnlock()
+	return e.snapshot()
+}
+
+func (e *Emulator) snapshot() (Snapshot, error) {
+	system, err := e.system.Snapshot()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return Snapshot{
+		Config:      e.config,
+		Seed:        e.seed,
+		NextOrderId: uint64(e.idGen),
+		System:      system,
+		Missed: MissedDeadlines{
+			maps.Clone(e.defaults.missed.Producing),
+			maps.Clone(e.defaults.missed.Ordering),
+		},
+		LastProducing: maps.Clone(e.defaults.lastProducing),
+		LastOrdering:  maps.Clone(e.defaults.lastOrdering),
+		Played:        e.played,
+	}, nil
+}
+
+// Restore replaces the emulated system with the snapshot, the configuration and the seed are taken from it.
+// The state is kept when the snapshot is invalid. The checkpoints start over with the restored cycle.
+func (e *Emulator) Restore(snapshot Snapshot) error {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+	return e.restore(snapshot, 0)
+}
+
+// restore replaces the system with the snapshot, only the checkpoints before the cycle keepBefore are kept
+func (e *Emulator) restore(snapshot Snapshot, keepBefore uint) error {
+	slog.Info("emulator.restore.started",
+		slog.Int("cycle", int(snapshot.System.CycleCounter)))
+
+	config := snapshot.Config
+	if config == nil {
+		return fmt.Errorf("%w: no configuration", domain.ErrInvalidSnapshot)
+	}
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidSnapshot, err)
+	}
+	bots, err := strategy.NewBots(config)
+	if err != nil {
+		return fmt.Errorf("invalid bots configuration: %w", err)
+	}
+	defaults, err := newDefaults(config.Deadlines)
+	if err != nil {
+		return fmt.Errorf("invalid deadlines configuration: %w", err)
+	}
+	idGen := domain.SequentialIdGenerator(snapshot.NextOrderId)
+	system, err := domain.RestoreSystem(&idGen, config, domain.NewConsumers(config.Consumers, snapshot.Seed), e.domainEvents, snapshot.System)
+	if err != nil {
+		slog.Error("emulator.restore.failed",
+			slog.String("error", err.Error()))
+		return err
+	}
+	maps.Copy(defaults.missed.Producing, snapshot.Missed.Producing)
+	maps.Copy(defaults.missed.Ordering, snapshot.Missed.Ordering)
+	maps.Copy(defaults.lastProducing, snapshot.LastProducing)
+	maps.Copy(defaults.lastOrdering, snapshot.LastOrdering)
+
+	e.idGen = idGen
+	e.system = system
+	e.config = config
+	e.seed = snapshot.Seed
+	e.bots = bots
+	e.defaults = defaults
+	e.played = snapshot.Played
+	if keepBefore > 0 {
+		// the history before the checkpoint is already in the log
+		rewound := snapshot
+		rewound.System.MarketHistory = nil
+		e.record(Command{Kind: CommandRewind, Snapshot: &rewound})
+	} else {
+		e.record(Command{Kind: CommandRestore, Snapshot: &snapshot})
+	}
+	e.startRun()
+	maps.DeleteFunc(e.checkpoints, func(cycle uint, _ Snapshot) bool { return cycle >= keepBefore })
+	e.checkpoint()
+
+	// the bots have acted before the snapshot, the restored phase goes on with the deadline
+	e.phaseStarted()
+	e.advance()
+
+	slog.Info("emulator.restore.completed",
+		slog.Int("cycle", int(snapshot.System.CycleCounter)),
+		slog.Int("orders", len(snapshot.System.Orders)))
+	return nil
+}
+
+// SaveSnapshot writes the snapshot to the JSON file, the file is replaced only when the snapshot is complete
+func (e *Emulator) SaveSnapshot(path string) error {
+	snapshot, err := e.Snapshot()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot restores the emulator from the JSON file written by SaveSnapshot
+func (e *Emulator) LoadSnapshot(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("failed to parse snapshot file: %w", err)
+	}
+	return e.Restore(snapshot)
+}
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"emulation/domain"
+)
+
+func TestSnapshot(t *testing.T) {
+	t.Run(`Given an emulator in the ordering phase with a missed deadline
+		When it is saved to a file and loaded by a fresh emulator
+		Then the fresh emulator continues the simulation exactly as the saved one`, func(t *testing.T) {
+
+		saved, err := NewEmulator(testConfig(), 7)
+		require.NoError(t, err)
+		defer saved.Close()
+		require.NoError(t, saved.StartOrdering())
+		path := filepath.Join(t.TempDir(), "snapshot.json")
+		require.NoError(t, saved.SaveSnapshot(path))
+
+		loaded, err := NewEmulator(testConfig(), 0)
+		require.NoError(t, err)
+		defer loaded.Close()
+		require.NoError(t, loaded.LoadSnapshot(path))
+
+		require.Equal(t, saved.GetSystemInfo(), loaded.GetSystemInfo())
+		require.Equal(t, uint64(7), loaded.Seed())
+		require.Equal(t, MissedDeadlines{
+			Producing: map[domain.ProducerId]uint{"p1": 1},
+			Ordering:  map[domain.OrderingAgentId]uint{},
+		}, loaded.GetMissedDeadlines())
+		for range 3 {
+			expected, err := saved.CompleteCycle()
+			require.NoError(t, err)
+			actual, err := loaded.CompleteCycle()
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(expected, actual))
+			require.NoError(t, saved.StartOrdering())
+			require.NoError(t, loaded.StartOrdering())
+		}
+		require.Empty(t, cmp.Diff(saved.GetMarketHistory(0, 10), loaded.GetMarketHistory(0, 10)))
+	})
+
+	t.Run(`Given a snapshot without the configuration
+		When it is restored
+		Then it is rejected and the emulator keeps its state`, func(t *testing.T) {
+
+		e, err := NewEmulator(testConfig(), 1)
+		require.NoError(t, err)
+		defer e.Close()
+		require.NoError(t, e.StartOrdering())
+		snapshot, err := e.Snapshot()
+		require.NoError(t, err)
+		snapshot.Config = nil
+
+		require.ErrorIs(t, e.Restore(snapshot), domain.ErrInvalidSnapshot)
+		require.Equal(t, "Ordering", e.GetSystemInfo().State)
+	})
+}
@@ -0,0 +1,128 @@
+package application
+
+import (
+	"log/slog"
+	"time"
+
+	"emulation/domain"
+)
+
+// RunInfo describes a game of a session: it starts with a reset, a restored snapshot, a rewind or a fork
+type RunInfo struct {
+	Id        string                `json:"id"`
+	SessionId string                `json:"sessionId"`
+	Started   time.Time             `json:"started"`
+	Seed      uint64                `json:"seed"`
+	Config    *domain.Configuration `json:"config,omitempty"`
+	// FromCycle is the first cycle of the run, later than 1 for the restored games
+	FromCycle uint `json:"fromCycle"`
+	// Cycles and Score are the number of the completed cycles and their total score
+	Cycles uint         `json:"cycles"`
+	Score  domain.Score `json:"score"`
+}
+
+// CycleRecord is a completed cycle of a run with its auction results
+type CycleRecord struct {
+	Cycle  uint                  `json:"cycle"`
+	Result domain.CycleResult    `json:"result"`
+	Market []domain.MarketRecord `json:"market,omitempty"`
+}
+
+// Store persists the runs of the sessions, their cycles and events. An unknown run is domain.ErrNotFound.
+type Store interface {
+	// CreateRun saves the new run and returns its generated id
+	CreateRun(run RunInfo) (string, error)
+	// AddCycle saves the completed cycle and adds it to the run's totals
+	AddCycle(runId string, cycle CycleRecord) error
+	AddEvents(runId string, events []Event) error
+	// Runs returns the runs in the order of creation, without their configurations
+	Runs() ([]RunInfo, error)
+	Run(id string) (RunInfo, error)
+	Cycles(runId string) ([]CycleRecord, error)
+	Events(runId string) ([]Event, error)
+	Close() error
+}
+
+// runRecorder saves the current run of the emulator, the events are saved with the cycles
+type runRecorder struct {
+	store     Store
+	sessionId string
+	runId     string
+	events    []Event
+}
+
+// Persist saves the games of the emulator to the store from the current cycle on
+func (e *Emulator) Persist(store Store, sessionId string) error {
+	e.rwMu.Lock()
+	defer e.rwMu.Unlock()
+	e.recorder = &runRecorder{store: store, sessionId: sessionId}
+	return e.startRun()
+}
+
+// RunId is the id of the current run in the store, empty when the emulator isn't persisted
+func (e *Emulator) RunId() string {
+	e.rwMu.RLock()
+	defer e.rwMu.RUnlock()
+	if e.recorder == nil {
+		return ""
+	}
+	return e.recorder.runId
+}
+
+// startRun ends the current run and saves the new one, a failing store doesn't stop the emulator
+func (e *Emulator) startRun() error {
+	r := e.recorder
+	if r == nil {
+		return nil
+	}
+	e.flushEvents()
+	id, err := r.store.CreateRun(RunInfo{
+		SessionId: r.sessionId,
+		Started:   time.Now().UTC(),
+		Seed:      e.seed,
+		Config:    e.config,
+		FromCycle: e.system.GetSystemInfo().CycleCounter,
+	})
+	if err != nil {
+		slog.Error("emulator.store.run_failed",
+			slog.String("sessionId", r.sessionId),
+			slog.String("error", err.Error()))
+		r.runId = ""
+		return err
+	}
+	r.runId = id
+	return nil
+}
+
+func (e *Emulator) storeEvent(event Event) {
+	if r := e.recorder; r != nil && r.runId != "" {
+		r.events = append(r.events, event)
+	}
+}
+
+func (e *Emulator) storeCycle(cycle uint, result domain.CycleResult) {
+	r := e.recorder
+	if r == nil || r.runId == "" {
+		return
+	}
+	e.flushEvents()
+	err := r.store.AddCycle(r.runId, CycleRecord{cycle, result, e.system.MarketHistory(cycle, cycle)})
+	if err != nil {
+		slog.Error("emulator.store.cycle_failed",
+			slog.String("runId", r.runId),
+			slog.String("error", err.Error()))
+	}
+}
+
+func (e *Emulator) flushEvents() {
+	r := e.recorder
+	if r == nil || r.runId == "" || len(r.events) == 0 {
+		return
+	}
+	if err := r.store.AddEvents(r.runId, r.events); err != nil {
+		slog.Error("emulator.store.events_failed",
+			slog.String("runId", r.runId),
+			slog.String("error", err.Error()))
+	}
+	r.events = nil
+}
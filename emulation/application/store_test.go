@@ -0,0 +1,86 @@
+package application
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"emulation/domain"
+)
+
+func TestBoltStore(t *testing.T) {
+	t.Run(`Given persisted sessions which played cycles, were rewound and closed
+		When the store is opened again
+		Then the runs with their cycles, market history and events are found`, func(t *testing.T) {
+
+		path := filepath.Join(t.TempDir(), "runs.db")
+		store, err := OpenBoltStore(path)
+		require.NoError(t, err)
+		sessions := NewSessions()
+		sessions.Persist(store)
+		_, e, err := sessions.Create("a", testConfig(), 4)
+		require.NoError(t, err)
+		require.Equal(t, "run-1", e.RunId())
+		var results []domain.CycleResult
+		for range 3 {
+			require.NoError(t, e.StartOrdering())
+			result, err := e.CompleteCycle()
+			require.NoError(t, err)
+			results = append(results, result)
+		}
+		require.NoError(t, e.Rewind(2))
+		require.Equal(t, "run-2", e.RunId())
+		require.NoError(t, e.StartOrdering())
+		sessions.Close()
+		require.NoError(t, store.Close())
+
+		store, err = OpenBoltStore(path)
+		require.NoError(t, err)
+		defer store.Close()
+		runs, err := store.Runs()
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+		require.Equal(t, RunInfo{
+			Id: "run-1", SessionId: "a", Started: runs[0].Started, Seed: 4, FromCycle: 1,
+			Cycles: 3, Score: results[0].Score + results[1].Score + results[2].Score,
+		}, runs[0])
+		require.Equal(t, uint(2), runs[1].FromCycle)
+
+		run, err := store.Run("run-1")
+		require.NoError(t, err)
+		require.Equal(t, testConfig(), run.Config)
+		cycles, err := store.Cycles("run-1")
+		require.NoError(t, err)
+		require.Len(t, cycles, 3)
+		require.Equal(t, uint(2), cycles[1].Cycle)
+		require.Equal(t, results[1].Score, cycles[1].Result.Score)
+		require.Empty(t, cmp.Diff(e.GetMarketHistory(1, 1), cycles[0].Market))
+
+		events, err := store.Events("run-1")
+		require.NoError(t, err)
+		require.Len(t, lo.Filter(events, func(e Event, _ int) bool { return e.Type == EventCycleCompleted }), 3)
+		events, err = store.Events("run-2")
+		require.NoError(t, err)
+		require.Equal(t, EventPhaseChanged, events[0].Type)
+		require.Equal(t, uint(2), events[0].Cycle)
+	})
+
+	t.Run(`Given a store
+		When an unknown run is requested
+		Then it is not found`, func(t *testing.T) {
+
+		store, err := OpenBoltStore(filepath.Join(t.TempDir(), "runs.db"))
+		require.NoError(t, err)
+		defer store.Close()
+
+		for _, id := range []string{"run-7", "nope"} {
+			_, err = store.Run(id)
+			require.ErrorIs(t, err, domain.ErrNotFound)
+			_, err = store.Cycles(id)
+			require.ErrorIs(t, err, domain.ErrNotFound)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+// Package client is a typed Go client of the tokenomics REST API described in swagger.yaml
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"emulation/models"
+)
+
+// APIKeyHeader carries the API key of the client
+const APIKeyHeader = "X-API-Key"
+
+// APIError is a non-successful response of the server
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// IsStatus tells whether the error is an API error with the status code
+func IsStatus(err error, code int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == code
+}
+
+type Client struct {
+	baseURL string
+	// APIKey is sent with every request unless empty
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+// New creates a client of the server at the base URL, e.g. http://localhost:8080
+func New(baseURL string) *Client {
+	return &Client{baseURL: strings.TrimSuffix(baseURL, "/"), HTTPClient: http.DefaultClient}
+}
+
+// Session returns the client of the session's endpoints, the session isn't checked
+func (c *Client) Session(id string) *Session {
+	return &Session{c, id}
+}
+
+func (c *Client) ListSessions(ctx context.Context) ([]*models.SessionInfo, error) {
+	var result []*models.SessionInfo
+	return result, c.do(ctx, http.MethodGet, "/sessions", nil, nil, &result)
+}
+
+func (c *Client) CreateSession(ctx context.Context, request *models.CreateSessionRequest) (*models.SessionInfo, error) {
+	var result models.SessionInfo
+	return &result, c.do(ctx, http.MethodPost, "/sessions", nil, request, &result)
+}
+
+// AnalyzeConfig reports the errors and the warnings of the configuration without applying it
+func (c *Client) AnalyzeConfig(ctx context.Context, config *models.Configuration) (*models.ConfigAnalysis, error) {
+	var result models.ConfigAnalysis
+	return &result, c.do(ctx, http.MethodPost, "/config/analyze", nil, config, &result)
+}
+
+// request builds the request to the path with the query, the body is sent as JSON unless nil
+func (c *Client) request(ctx context.Context, method, path string, query url.Values, body any) (*http.Request, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.APIKey != "" {
+		req.Header.Set(APIKeyHeader, c.APIKey)
+	}
+	return req, nil
+}
+
+// do sends the request and decodes the response into the result unless it is nil
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, result any) error {
+	req, err := c.request(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return responseError(resp)
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("%s %s: decoding response: %w", method, path, err)
+	}
+	return nil
+}
+
+// responseError reads the error message, the server sends either a JSON string or an object with a message
+func responseError(resp *http.Response) error {
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	message := strings.TrimSpace(string(data))
+	var text string
+	var object struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(data, &text) == nil {
+		message = text
+	} else if json.Unmarshal(data, &object) == nil && object.Message != "" {
+		message = object.Message
+	}
+	return &APIError{resp.StatusCode, message}
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"emulation/models"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run(`Given a client with an API key
+		When it sends a command to a session's agent
+		Then the request carries the key and the JSON command on the session's path`, func(t *testing.T) {
+
+		var got *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer server.Close()
+		c := New(server.URL + "/")
+		c.APIKey = "secret"
+
+		err := c.Session("s 1").SendProducingCommand(ctx, "p1", &models.ProducingAgentCommand{DoUpgrade: true})
+		require.NoError(t, err)
+		require.Equal(t, http.MethodPost, got.Method)
+		require.Equal(t, "/sessions/s%201/producing-agents/p1", got.URL.EscapedPath())
+		require.Equal(t, "secret", got.Header.Get(APIKeyHeader))
+		require.JSONEq(t, `{"doUpgrade": true}`, string(body))
+	})
+
+	t.Run(`Given a waiting agent
+		When it waits for the phase change
+		Then the known phase and the timeout are sent in the query
+		And the new phase is returned`, func(t *testing.T) {
+
+		var query string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Path + "?" + r.URL.RawQuery
+			fmt.Fprint(w, `{"cycleCounter": 3, "state": "OrdersPlacement"}`)
+		}))
+		defer server.Close()
+
+		result, err := New(server.URL).Session("default").WaitPhase(ctx, models.SystemInfo{CycleCounter: 2, State: "Ordering"}, 1500*time.Millisecond)
+		require.NoError(t, err)
+		require.Equal(t, "/sessions/default/system/wait?cycleCounter=2&state=Ordering&timeoutMs=1500", query)
+		require.Equal(t, &models.SystemInfo{CycleCounter: 3, State: "OrdersPlacement"}, result)
+	})
+
+	t.Run(`Given the server refusing the requests
+		When the client calls it
+		Then the status and the message are returned as an API error`, func(t *testing.T) {
+
+		for body, message := range map[string]string{
+			`"session nope: not found"`:                   "session nope: not found",
+			`{"code": 404, "message": "unknown session"}`: "unknown session",
+			"plain text": "plain text",
+		} {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, body)
+			}))
+			_, err := New(server.URL).Session("nope").Info(ctx)
+			server.Close()
+
+			require.True(t, IsStatus(err, http.StatusNotFound))
+			require.Equal(t, &APIError{http.StatusNotFound, message}, err)
+		}
+	})
+
+	t.Run(`Given a server-sent event stream with comments
+		When the client reads it
+		Then the events are decoded until the stream ends`, func(t *testing.T) {
+
+		var agents string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			agents = r.URL.Query().Get("agentId")
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, ": keep-alive\n\n")
+			fmt.Fprint(w, "id: 1\nevent: phase.changed\ndata: {\"seq\": 1, \"cycle\": 1, \"type\": \"phase.changed\"}\n\n")
+			fmt.Fprint(w, "id: 2\nevent: cycle.completed\ndata: {\"seq\": 2, \"cycle\": 1, \"type\": \"cycle.completed\"}\n\n")
+		}))
+		defer server.Close()
+
+		stream, err := New(server.URL).Session("default").Events(ctx, "c1", "p1")
+		require.NoError(t, err)
+		defer stream.Close()
+		var types []string
+		for {
+			event, err := stream.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			types = append(types, lo.FromPtr(event.Type))
+		}
+		require.Equal(t, "c1,p1", agents)
+		require.Equal(t, []string{"phase.changed", "cycle.completed"}, types)
+	})
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/websocket"
+
+	"emulation/models"
+)
+
+// EventStream is a stream of the session's events, Next returns io.EOF when the server ends the stream
+type EventStream interface {
+	Next() (*models.Event, error)
+	Close() error
+}
+
+func eventsQuery(agentIds []string) url.Values {
+	if len(agentIds) == 0 {
+		return nil
+	}
+	return url.Values{"agentId": {strings.Join(agentIds, ",")}}
+}
+
+// Events streams the session's events over server-sent events, the agent events are filtered by the agent ids
+// unless none are given. The stream ends with the context.
+func (s *Session) Events(ctx context.Context, agentIds ...string) (EventStream, error) {
+	req, err := s.c.request(ctx, http.MethodGet, s.path("events"), eventsQuery(agentIds), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := s.c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, responseError(resp)
+	}
+	return &sseStream{resp.Body, bufio.NewScanner(resp.Body)}, nil
+}
+
+type sseStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+}
+
+// Next reads the data lines up to the blank line ending the event, the comments and the other fields are skipped
+func (s *sseStream) Next() (*models.Event, error) {
+	var data []string
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+		if line == "" {
+			if len(data) == 0 {
+				continue
+			}
+			var event models.Event
+			if err := json.Unmarshal([]byte(strings.Join(data, "\n")), &event); err != nil {
+				return nil, fmt.Errorf("decoding event: %w", err)
+			}
+			return &event, nil
+		}
+		if value, ok := strings.CutPrefix(line, "data:"); ok {
+			data = append(data, strings.TrimPrefix(value, " "))
+		}
+	}
+	if err := s.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (s *sseStream) Close() error {
+	return s.body.Close()
+}
+
+// EventsWebSocket streams the session's events over a WebSocket, the agent events are filtered by the agent ids
+// unless none are given
+func (s *Session) EventsWebSocket(ctx context.Context, agentIds ...string) (EventStream, error) {
+	u, err := url.Parse(s.c.baseURL + s.path("events", "ws"))
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	}
+	u.RawQuery = eventsQuery(agentIds).Encode()
+	header := http.Header{}
+	if s.c.APIKey != "" {
+		header.Set(APIKeyHeader, s.c.APIKey)
+	}
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		if resp != nil && resp.StatusCode >= 300 {
+			defer resp.Body.Close()
+			return nil, responseError(resp)
+		}
+		return nil, err
+	}
+	return &webSocketStream{conn}, nil
+}
+
+type webSocketStream struct {
+	conn *websocket.Conn
+}
+
+func (s *webSocketStream) Next() (*models.Event, error) {
+	var event models.Event
+	if err := s.conn.ReadJSON(&event); err != nil {
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (s *webSocketStream) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"emulation/models"
+)
+
+// Runs returns the persisted runs of the session, or of all sessions when the id is empty
+func (c *Client) Runs(ctx context.Context, sessionId string) ([]*models.RunInfo, error) {
+	var query url.Values
+	if sessionId != "" {
+		query = url.Values{"sessionId": {sessionId}}
+	}
+	var result []*models.RunInfo
+	return result, c.do(ctx, http.MethodGet, "/runs", query, nil, &result)
+}
+
+// Run returns the persisted run with its configuration
+func (c *Client) Run(ctx context.Context, id string) (*models.RunInfo, error) {
+	var result models.RunInfo
+	return &result, c.do(ctx, http.MethodGet, "/runs/"+url.PathEscape(id), nil, nil, &result)
+}
+
+// RunCycles returns the completed cycles of the run, each as JSON, see application.CycleRecord
+func (c *Client) RunCycles(ctx context.Context, id string) ([]json.RawMessage, error) {
+	var result []json.RawMessage
+	return result, c.do(ctx, http.MethodGet, "/runs/"+url.PathEscape(id)+"/cycles", nil, nil, &result)
+}
+
+func (c *Client) RunEvents(ctx context.Context, id string) ([]*models.Event, error) {
+	var result []*models.Event
+	return result, c.do(ctx, http.MethodGet, "/runs/"+url.PathEscape(id)+"/events", nil, nil, &result)
+}
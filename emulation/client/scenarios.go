@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"emulation/models"
+)
+
+// Scenarios returns the scenarios of the server's library without their configurations
+func (c *Client) Scenarios(ctx context.Context) ([]*models.Scenario, error) {
+	var result []*models.Scenario
+	return result, c.do(ctx, http.MethodGet, "/scenarios", nil, nil, &result)
+}
+
+// Scenario returns the scenario with its configuration
+func (c *Client) Scenario(ctx context.Context, name string) (*models.Scenario, error) {
+	var result models.Scenario
+	return &result, c.do(ctx, http.MethodGet, "/scenarios/"+url.PathEscape(name), nil, nil, &result)
+}
+
+// LoadScenario resets the session into the scenario of the library
+func (s *Session) LoadScenario(ctx context.Context, name string) (*models.SessionInfo, error) {
+	var result models.SessionInfo
+	return &result, s.c.do(ctx, http.MethodPost, s.path("scenario"), nil, &models.LoadScenarioRequest{Name: &name}, &result)
+}
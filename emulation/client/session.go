@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"emulation/models"
+)
+
+// Session is the client of a session's endpoints
+type Session struct {
+	c  *Client
+	id string
+}
+
+func (s *Session) Id() string {
+	return s.id
+}
+
+func (s *Session) path(elems ...string) string {
+	path := "/sessions/" + url.PathEscape(s.id)
+	for _, e := range elems {
+		path += "/" + url.PathEscape(e)
+	}
+	return path
+}
+
+func (s *Session) Info(ctx context.Context) (*models.SessionInfo, error) {
+	var result models.SessionInfo
+	return &result, s.c.do(ctx, http.MethodGet, s.path(), nil, nil, &result)
+}
+
+// Delete stops the session's timers and ends its event streams
+func (s *Session) Delete(ctx context.Context) error {
+	return s.c.do(ctx, http.MethodDelete, s.path(), nil, nil, nil)
+}
+
+// Checkpoints returns the cycles the session can be rewound to or forked at
+func (s *Session) Checkpoints(ctx context.Context) ([]int64, error) {
+	var result []int64
+	return result, s.c.do(ctx, http.MethodGet, s.path("checkpoints"), nil, nil, &result)
+}
+
+// Rewind returns the session to the start of the cycle, the later cycles are dropped
+func (s *Session) Rewind(ctx context.Context, cycle int64) (*models.SystemInfo, error) {
+	var result models.SystemInfo
+	return &result, s.c.do(ctx, http.MethodPost, s.path("rewind"), nil, &models.RewindRequest{Cycle: &cycle}, &result)
+}
+
+// Fork starts a branch session at the checkpoint of the request's cycle, the session stays intact
+func (s *Session) Fork(ctx context.Context, request *models.ForkRequest) (*models.SessionInfo, error) {
+	var result models.SessionInfo
+	return &result, s.c.do(ctx, http.MethodPost, s.path("fork"), nil, request, &result)
+}
+
+func (s *Session) SystemInfo(ctx context.Context) (*models.SystemInfo, error) {
+	var result []*models.SystemInfo
+	if err := s.c.do(ctx, http.MethodGet, s.path("system"), nil, nil, &result); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, errors.New("empty system info")
+	}
+	return result[0], nil
+}
+
+// Reset starts the simulation over with the current configuration
+func (s *Session) Reset(ctx context.Context) error {
+	return s.c.do(ctx, http.MethodDelete, s.path("system"), nil, nil, nil)
+}
+
+func (s *Session) StartOrdering(ctx context.Context) error {
+	return s.c.do(ctx, http.MethodPost, s.path("system", "start-ordering"), nil, nil, nil)
+}
+
+func (s *Session) CompleteCycle(ctx context.Context) (*models.CycleResult, error) {
+	var result models.CycleResult
+	return &result, s.c.do(ctx, http.MethodPost, s.path("system", "complete-cycle"), nil, nil, &result)
+}
+
+func (s *Session) MissedDeadlines(ctx context.Context) ([]*models.MissedDeadlines, error) {
+	var result []*models.MissedDeadlines
+	return result, s.c.do(ctx, http.MethodGet, s.path("system", "missed-deadlines"), nil, nil, &result)
+}
+
+// WaitPhase waits until the system leaves the known phase or the timeout passes, zero timeout is the server's default
+func (s *Session) WaitPhase(ctx context.Context, known models.SystemInfo, timeout time.Duration) (*models.SystemInfo, error) {
+	query := url.Values{
+		"cycleCounter": {strconv.FormatInt(known.CycleCounter, 10)},
+		"state":        {known.State},
+	}
+	if timeout > 0 {
+		query.Set("timeoutMs", strconv.FormatInt(timeout.Milliseconds(), 10))
+	}
+	var result models.SystemInfo
+	return &result, s.c.do(ctx, http.MethodGet, s.path("system", "wait"), query, nil, &result)
+}
+
+// MarketFilter narrows the market history, the zero value takes all records
+type MarketFilter struct {
+	FromCycle  *int64
+	ToCycle    *int64
+	ProducerId string
+}
+
+func (s *Session) MarketHistory(ctx context.Context, filter MarketFilter) ([]*models.MarketRecord, error) {
+	query := url.Values{}
+	if filter.FromCycle != nil {
+		query.Set("fromCycle", strconv.FormatInt(*filter.FromCycle, 10))
+	}
+	if filter.ToCycle != nil {
+		query.Set("toCycle", strconv.FormatInt(*filter.ToCycle, 10))
+	}
+	if filter.ProducerId != "" {
+		query.Set("producerId", filter.ProducerId)
+	}
+	var result []*models.MarketRecord
+	return result, s.c.do(ctx, http.MethodGet, s.path("market", "history"), query, nil, &result)
+}
+
+func (s *Session) ProducingAgents(ctx context.Context) ([]*models.ProducingAgentInfo, error) {
+	var result []*models.ProducingAgentInfo
+	return result, s.c.do(ctx, http.MethodGet, s.path("producer-agents"), nil, nil, &result)
+}
+
+func (s *Session) OrderingAgents(ctx context.Context) ([]*models.OrderingAgentInfo, error) {
+	var result []*models.OrderingAgentInfo
+	return result, s.c.do(ctx, http.MethodGet, s.path("ordering-agents"), nil, nil, &result)
+}
+
+func (s *Session) OrderingAgentView(ctx context.Context, id string) (*models.OrderingAgentView, error) {
+	var result models.OrderingAgentView
+	return &result, s.c.do(ctx, http.MethodGet, s.path("ordering-agents", id), nil, nil, &result)
+}
+
+func (s *Session) SendOrderingCommand(ctx context.Context, id string, cmd *models.OrderingAgentCommand) error {
+	return s.c.do(ctx, http.MethodPost, s.path("ordering-agents", id), nil, cmd, nil)
+}
+
+// PassOrdering ends the ordering agent's turn without bids
+func (s *Session) PassOrdering(ctx context.Context, id string) error {
+	return s.c.do(ctx, http.MethodPost, s.path("ordering-agents", id, "pass"), nil, nil, nil)
+}
+
+func (s *Session) ProducingAgentView(ctx context.Context, id string) (*models.ProducingAgentView, error) {
+	var result models.ProducingAgentView
+	return &result, s.c.do(ctx, http.MethodGet, s.path("producing-agents", id), nil, nil, &result)
+}
+
+func (s *Session) SendProducingCommand(ctx context.Context, id string, cmd *models.ProducingAgentCommand) error {
+	return s.c.do(ctx, http.MethodPost, s.path("producing-agents", id), nil, cmd, nil)
+}
+
+// PassProducing ends the producing agent's turn without investments
+func (s *Session) PassProducing(ctx context.Context, id string) error {
+	return s.c.do(ctx, http.MethodPost, s.path("producing-agents", id, "pass"), nil, nil, nil)
+}
+
+// Snapshot exports the full state of the simulation as JSON, see application.Snapshot
+func (s *Session) Snapshot(ctx context.Context) (json.RawMessage, error) {
+	var result json.RawMessage
+	return result, s.c.do(ctx, http.MethodGet, s.path("system", "snapshot"), nil, nil, &result)
+}
+
+// RestoreSnapshot replaces the simulation with the exported snapshot
+func (s *Session) RestoreSnapshot(ctx context.Context, snapshot json.RawMessage) error {
+	return s.c.do(ctx, http.MethodPut, s.path("system", "snapshot"), nil, snapshot, nil)
+}
+
+// Commands returns the command log from the sequence number on, each command as JSON, see application.Command
+func (s *Session) Commands(ctx context.Context, fromSeq int64) ([]json.RawMessage, error) {
+	var query url.Values
+	if fromSeq > 0 {
+		query = url.Values{"fromSeq": {strconv.FormatInt(fromSeq, 10)}}
+	}
+	var result []json.RawMessage
+	return result, s.c.do(ctx, http.MethodGet, s.path("system", "commands"), query, nil, &result)
+}
+
+func (s *Session) Config(ctx context.Context) (*models.Configuration, error) {
+	var result models.Configuration
+	return &result, s.c.do(ctx, http.MethodGet, s.path("config"), nil, nil, &result)
+}
+
+// UpdateConfig replaces the configuration, it takes effect on the next reset or at once when applied
+func (s *Session) UpdateConfig(ctx context.Context, config *models.Configuration, apply bool) error {
+	return s.c.do(ctx, http.MethodPut, s.path("config"), applyQuery(apply), config, nil)
+}
+
+// RevertConfig replaces the configuration with the server's file and returns it
+func (s *Session) RevertConfig(ctx context.Context, apply bool) (*models.Configuration, error) {
+	var result models.Configuration
+	return &result, s.c.do(ctx, http.MethodDelete, s.path("config"), applyQuery(apply), nil, &result)
+}
+
+// AnalyzeConfig reports the errors and the warnings of the session's configuration
+func (s *Session) AnalyzeConfig(ctx context.Context) (*models.ConfigAnalysis, error) {
+	var result models.ConfigAnalysis
+	return &result, s.c.do(ctx, http.MethodGet, s.path("config", "analyze"), nil, nil, &result)
+}
+
+func applyQuery(apply bool) url.Values {
+	if !apply {
+		return nil
+	}
+	return url.Values{"apply": {"true"}}
+}
+
+func (s *Session) ResetGym(ctx context.Context, request *models.GymResetRequest) (*models.GymObservation, error) {
+	var result models.GymObservation
+	return &result, s.c.do(ctx, http.MethodPost, s.path("gym", "reset"), nil, request, &result)
+}
+
+func (s *Session) StepGym(ctx context.Context, actions *models.GymActions) (*models.GymStepResult, error) {
+	var result models.GymStepResult
+	return &result, s.c.do(ctx, http.MethodPost, s.path("gym", "step"), nil, actions, &result)
+}
@@ -0,0 +1,759 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"emulation/client"
+	"emulation/models"
+)
+
+type options struct {
+	URL     string `short:"u" long:"url" env:"TOKENOMICS_URL" default:"http://localhost:8080" description:"server URL"`
+	APIKey  string `short:"k" long:"api-key" env:"TOKENOMICS_API_KEY" description:"API key sent in the X-API-Key header"`
+	Session string `short:"s" long:"session" env:"TOKENOMICS_SESSION" default:"default" description:"session id"`
+	Timeout int    `short:"t" long:"timeout" default:"60" description:"request timeout in seconds, the event stream runs until interrupted"`
+}
+
+var opts options
+
+func newClient() *client.Client {
+	c := client.New(opts.URL)
+	c.APIKey = opts.APIKey
+	return c
+}
+
+// session is the client of the selected session
+func session() *client.Session {
+	return newClient().Session(opts.Session)
+}
+
+func requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), time.Duration(opts.Timeout)*time.Second)
+}
+
+// printJSON writes the result as indented JSON
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// readJSON decodes the file, "-" is the standard input
+func readJSON(path string, v any) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	return json.NewDecoder(r).Decode(v)
+}
+
+type sessionsCommand struct{}
+
+func (sessionsCommand) Execute([]string) error {
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := newClient().ListSessions(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type createSessionCommand struct {
+	Id       string `long:"id" description:"session id, generated when empty"`
+	Seed     int64  `long:"seed" description:"seed of the consumers' demand"`
+	Config   string `long:"config" description:"JSON configuration file, the server's configuration when empty"`
+	Scenario string `long:"scenario" description:"scenario of the server's library instead of the configuration file"`
+}
+
+func (cmd createSessionCommand) Execute([]string) error {
+	request := &models.CreateSessionRequest{ID: cmd.Id, Seed: cmd.Seed, Scenario: cmd.Scenario}
+	if cmd.Config != "" {
+		request.Config = &models.Configuration{}
+		if err := readJSON(cmd.Config, request.Config); err != nil {
+			return err
+		}
+	}
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := newClient().CreateSession(ctx, request)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type deleteSessionCommand struct{}
+
+func (deleteSessionCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.Delete(ctx)
+}
+
+type checkpointsCommand struct{}
+
+func (checkpointsCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.Checkpoints(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type rewindCommand struct {
+	Args struct {
+		Cycle int64 `positional-arg-name:"cycle" required:"yes" description:"cycle to rewind to"`
+	} `positional-args:"yes"`
+}
+
+func (cmd rewindCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.Rewind(ctx, cmd.Args.Cycle)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type forkCommand struct {
+	Id     string `long:"id" description:"branch session id, generated when empty"`
+	Config string `long:"config" description:"JSON configuration file of the branch, the session's configuration when empty"`
+	Args   struct {
+		Cycle int64 `positional-arg-name:"cycle" required:"yes" description:"cycle the branch starts at"`
+	} `positional-args:"yes"`
+}
+
+func (cmd forkCommand) Execute([]string) error {
+	request := &models.ForkRequest{ID: cmd.Id, Cycle: &cmd.Args.Cycle}
+	if cmd.Config != "" {
+		request.Config = &models.Configuration{}
+		if err := readJSON(cmd.Config, request.Config); err != nil {
+			return err
+		}
+	}
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.Fork(ctx, request)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type infoCommand struct{}
+
+func (infoCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.Info(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type resetCommand struct{}
+
+func (resetCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.Reset(ctx)
+}
+
+type startOrderingCommand struct{}
+
+func (startOrderingCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.StartOrdering(ctx)
+}
+
+type completeCycleCommand struct{}
+
+func (completeCycleCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.CompleteCycle(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type waitCommand struct {
+	Wait int `long:"wait" default:"30" description:"seconds to wait for the phase change"`
+}
+
+func (cmd waitCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	known, err := s.SystemInfo(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := s.WaitPhase(ctx, *known, time.Duration(cmd.Wait)*time.Second)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type missedCommand struct{}
+
+func (missedCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.MissedDeadlines(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type marketCommand struct {
+	From     *int64 `long:"from" description:"first cycle"`
+	To       *int64 `long:"to" description:"last cycle"`
+	Producer string `long:"producer" description:"producer id"`
+}
+
+func (cmd marketCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.MarketHistory(ctx, client.MarketFilter{FromCycle: cmd.From, ToCycle: cmd.To, ProducerId: cmd.Producer})
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type agentsCommand struct{}
+
+func (agentsCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	ordering, err := s.OrderingAgents(ctx)
+	if err != nil {
+		return err
+	}
+	producing, err := s.ProducingAgents(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(map[string]any{"ordering": ordering, "producing": producing})
+}
+
+type agentArg struct {
+	Id string `positional-arg-name:"agent-id" required:"yes"`
+}
+
+type orderingViewCommand struct {
+	Args agentArg `positional-args:"yes"`
+}
+
+func (cmd orderingViewCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.OrderingAgentView(ctx, cmd.Args.Id)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type producingViewCommand struct {
+	Args agentArg `positional-args:"yes"`
+}
+
+func (cmd producingViewCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.ProducingAgentView(ctx, cmd.Args.Id)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type orderCommand struct {
+	Bids []string `short:"b" long:"bid" description:"bid as order-id:producer-id=tokens, repeated for every bid"`
+	Args agentArg `positional-args:"yes"`
+}
+
+func (cmd orderCommand) Execute([]string) error {
+	orders, err := parseBids(cmd.Bids)
+	if err != nil {
+		return err
+	}
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.SendOrderingCommand(ctx, cmd.Args.Id, &models.OrderingAgentCommand{Orders: orders})
+}
+
+// parseBids groups the order-id:producer-id=tokens bids by the orders
+func parseBids(bids []string) (map[string]map[string]int64, error) {
+	orders := map[string]map[string]int64{}
+	for _, b := range bids {
+		order, rest, ok := strings.Cut(b, ":")
+		producer, value, ok2 := strings.Cut(rest, "=")
+		if !ok || !ok2 {
+			return nil, fmt.Errorf("bid [%s] is not order-id:producer-id=tokens", b)
+		}
+		tokens, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bid [%s]: %w", b, err)
+		}
+		if orders[order] == nil {
+			orders[order] = map[string]int64{}
+		}
+		orders[order][producer] = tokens
+	}
+	return orders, nil
+}
+
+type investCommand struct {
+	Upgrade     bool     `long:"upgrade" description:"order an upgrade"`
+	Restoration bool     `long:"restoration" description:"order a restoration"`
+	Args        agentArg `positional-args:"yes"`
+}
+
+func (cmd investCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.SendProducingCommand(ctx, cmd.Args.Id, &models.ProducingAgentCommand{DoUpgrade: cmd.Upgrade, DoRestoration: cmd.Restoration})
+}
+
+type passOrderingCommand struct {
+	Args agentArg `positional-args:"yes"`
+}
+
+func (cmd passOrderingCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.PassOrdering(ctx, cmd.Args.Id)
+}
+
+type passProducingCommand struct {
+	Args agentArg `positional-args:"yes"`
+}
+
+func (cmd passProducingCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.PassProducing(ctx, cmd.Args.Id)
+}
+
+type configCommand struct{}
+
+func (configCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.Config(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type fileArg struct {
+	File string `positional-arg-name:"file" required:"yes" description:"JSON file, - for the standard input"`
+}
+
+type updateConfigCommand struct {
+	Apply bool    `long:"apply" description:"reset the simulation with the configuration at once"`
+	Args  fileArg `positional-args:"yes"`
+}
+
+func (cmd updateConfigCommand) Execute([]string) error {
+	var config models.Configuration
+	if err := readJSON(cmd.Args.File, &config); err != nil {
+		return err
+	}
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.UpdateConfig(ctx, &config, cmd.Apply)
+}
+
+type revertConfigCommand struct {
+	Apply bool `long:"apply" description:"reset the simulation with the configuration at once"`
+}
+
+func (cmd revertConfigCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.RevertConfig(ctx, cmd.Apply)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type analyzeConfigCommand struct {
+	Args struct {
+		File string `positional-arg-name:"file" description:"JSON configuration file to analyse, - for the standard input, the session's configuration when empty"`
+	} `positional-args:"yes"`
+}
+
+// Execute prints the analysis and fails when the configuration has errors
+func (cmd analyzeConfigCommand) Execute([]string) error {
+	ctx, cancel := requestContext()
+	defer cancel()
+	var result *models.ConfigAnalysis
+	var err error
+	if cmd.Args.File == "" {
+		result, err = session().AnalyzeConfig(ctx)
+	} else {
+		var config models.Configuration
+		if err := readJSON(cmd.Args.File, &config); err != nil {
+			return err
+		}
+		result, err = newClient().AnalyzeConfig(ctx, &config)
+	}
+	if err != nil {
+		return err
+	}
+	if err := printJSON(result); err != nil {
+		return err
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("the configuration has %d errors", len(result.Errors))
+	}
+	return nil
+}
+
+type snapshotCommand struct{}
+
+func (snapshotCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.Snapshot(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type restoreCommand struct {
+	Args fileArg `positional-args:"yes"`
+}
+
+func (cmd restoreCommand) Execute([]string) error {
+	var snapshot json.RawMessage
+	if err := readJSON(cmd.Args.File, &snapshot); err != nil {
+		return err
+	}
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	return s.RestoreSnapshot(ctx, snapshot)
+}
+
+type commandsCommand struct {
+	FromSeq int64 `long:"from" description:"number of the first command, the log starts with 1"`
+}
+
+// Execute writes the command log as JSON lines, the format read by tokenomics-replay
+func (cmd commandsCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	commands, err := s.Commands(ctx, cmd.FromSeq)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	for _, c := range commands {
+		if err := enc.Encode(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type scenariosCommand struct{}
+
+func (scenariosCommand) Execute([]string) error {
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := newClient().Scenarios(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type scenarioArg struct {
+	Name string `positional-arg-name:"name" required:"yes" description:"scenario name, the file name without the extension"`
+}
+
+type scenarioCommand struct {
+	Args scenarioArg `positional-args:"yes"`
+}
+
+func (cmd scenarioCommand) Execute([]string) error {
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := newClient().Scenario(ctx, cmd.Args.Name)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type loadScenarioCommand struct {
+	Args scenarioArg `positional-args:"yes"`
+}
+
+func (cmd loadScenarioCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.LoadScenario(ctx, cmd.Args.Name)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type runsCommand struct {
+	All bool `long:"all" description:"list the runs of all sessions instead of the selected one"`
+}
+
+func (cmd runsCommand) Execute([]string) error {
+	sessionId := opts.Session
+	if cmd.All {
+		sessionId = ""
+	}
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := newClient().Runs(ctx, sessionId)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type runArg struct {
+	Id string `positional-arg-name:"run" required:"yes" description:"run id, e.g. run-1"`
+}
+
+type runCommand struct {
+	Args runArg `positional-args:"yes"`
+}
+
+func (cmd runCommand) Execute([]string) error {
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := newClient().Run(ctx, cmd.Args.Id)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type runCyclesCommand struct {
+	Args runArg `positional-args:"yes"`
+}
+
+func (cmd runCyclesCommand) Execute([]string) error {
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := newClient().RunCycles(ctx, cmd.Args.Id)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type runEventsCommand struct {
+	Args runArg `positional-args:"yes"`
+}
+
+// Execute writes the run's events as JSON lines like the events command
+func (cmd runEventsCommand) Execute([]string) error {
+	ctx, cancel := requestContext()
+	defer cancel()
+	events, err := newClient().RunEvents(ctx, cmd.Args.Id)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type gymResetCommand struct {
+	Cycles int64 `long:"cycles" required:"yes" description:"episode length in cycles"`
+	Seed   int64 `long:"seed" description:"seed of the consumers' demand"`
+}
+
+func (cmd gymResetCommand) Execute([]string) error {
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.ResetGym(ctx, &models.GymResetRequest{Cycles: &cmd.Cycles, Seed: cmd.Seed})
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type gymStepCommand struct {
+	Args fileArg `positional-args:"yes"`
+}
+
+func (cmd gymStepCommand) Execute([]string) error {
+	var actions models.GymActions
+	if err := readJSON(cmd.Args.File, &actions); err != nil {
+		return err
+	}
+	s := session()
+	ctx, cancel := requestContext()
+	defer cancel()
+	result, err := s.StepGym(ctx, &actions)
+	if err != nil {
+		return err
+	}
+	return printJSON(result)
+}
+
+type eventsCommand struct {
+	Agents    []string `short:"a" long:"agent" description:"agent id to filter the agent events, repeated for every agent"`
+	WebSocket bool     `long:"ws" description:"stream over a WebSocket instead of server-sent events"`
+}
+
+func (cmd eventsCommand) Execute([]string) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	open := session().Events
+	if cmd.WebSocket {
+		open = session().EventsWebSocket
+	}
+	stream, err := open(ctx, cmd.Agents...)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	go func() {
+		<-ctx.Done()
+		stream.Close()
+	}()
+
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		event, err := stream.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if err := enc.Encode(event); err != nil {
+			return err
+		}
+	}
+}
+
+func main() {
+	parser := flags.NewParser(&opts, flags.Default)
+	commands := []struct {
+		name, description string
+		command           any
+	}{
+		{"sessions", "list the sessions", &sessionsCommand{}},
+		{"create-session", "create a session", &createSessionCommand{}},
+		{"delete-session", "delete the session", &deleteSessionCommand{}},
+		{"info", "show the session's seed, cycle and phase", &infoCommand{}},
+		{"checkpoints", "list the cycles the session can be rewound to", &checkpointsCommand{}},
+		{"rewind", "rewind the session to the start of the cycle", &rewindCommand{}},
+		{"fork", "fork a branch session from the cycle, the session stays intact", &forkCommand{}},
+		{"reset", "start the simulation over", &resetCommand{}},
+		{"start-ordering", "end the investment phase", &startOrderingCommand{}},
+		{"complete-cycle", "end the ordering phase and complete the cycle", &completeCycleCommand{}},
+		{"wait", "wait for the phase change", &waitCommand{}},
+		{"missed-deadlines", "show the missed phase deadlines", &missedCommand{}},
+		{"market", "show the market history", &marketCommand{}},
+		{"agents", "list the ordering and the producing agents", &agentsCommand{}},
+		{"ordering-view", "show the ordering agent's view", &orderingViewCommand{}},
+		{"producing-view", "show the producing agent's view", &producingViewCommand{}},
+		{"order", "send the ordering agent's bids", &orderCommand{}},
+		{"invest", "send the producing agent's investments", &investCommand{}},
+		{"pass-ordering", "end the ordering agent's turn without bids", &passOrderingCommand{}},
+		{"pass-producing", "end the producing agent's turn without investments", &passProducingCommand{}},
+		{"config", "show the configuration", &configCommand{}},
+		{"update-config", "replace the configuration, it takes effect on reset or at once with --apply", &updateConfigCommand{}},
+		{"revert-config", "revert to the server's configuration file", &revertConfigCommand{}},
+		{"analyze-config", "analyse the session's configuration or a configuration file", &analyzeConfigCommand{}},
+		{"scenarios", "list the scenarios of the server's library", &scenariosCommand{}},
+		{"scenario", "show the scenario with its configuration", &scenarioCommand{}},
+		{"load-scenario", "start the simulation over with the scenario", &loadScenarioCommand{}},
+		{"snapshot", "export the full state of the simulation", &snapshotCommand{}},
+		{"restore", "restore the simulation from an exported snapshot", &restoreCommand{}},
+		{"commands", "write the command log as JSON lines", &commandsCommand{}},
+		{"runs", "list the persisted runs of the session", &runsCommand{}},
+		{"run", "show the persisted run with its configuration", &runCommand{}},
+		{"run-cycles", "show the completed cycles of the persisted run", &runCyclesCommand{}},
+		{"run-events", "write the events of the persisted run as JSON lines", &runEventsCommand{}},
+		{"gym-reset", "start a gym episode", &gymResetCommand{}},
+		{"gym-step", "play a gym step with the actions", &gymStepCommand{}},
+		{"events", "stream the session's events as JSON lines until interrupted", &eventsCommand{}},
+	}
+	for _, c := range commands {
+		if _, err := parser.AddCommand(c.name, c.description, c.description, c.command); err != nil {
+			log.Fatalln(err)
+		}
+	}
+	if _, err := parser.Parse(); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+}
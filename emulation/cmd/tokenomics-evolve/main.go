@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"emulation/application"
+	"emulation/evolution"
+)
+
+type options struct {
+	Config   string `short:"c" long:"config" default:"config.json" description:"base configuration file, its bots run the evolved strategies"`
+	Settings string `short:"s" long:"settings" default:"evolution.json" description:"evolution settings file with the genes to evolve"`
+	Output   string `short:"o" long:"output" default:"evolution-out" description:"directory to write the best genomes to"`
+	Workers  int    `short:"w" long:"workers" description:"number of parallel simulations (default: settings or number of CPUs)"`
+}
+
+func main() {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+
+	if err := run(opts); err != nil {
+		// the log package is routed to the discarded slog output
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run evolves the genomes and writes the generations and the best configuration
+func run(opts options) error {
+	logger := slog.New(slog.DiscardHandler)
+	slog.SetDefault(logger)
+
+	config, err := application.LoadConfig(opts.Config)
+	if err != nil {
+		return err
+	}
+	settingsData, err := os.ReadFile(opts.Settings)
+	if err != nil {
+		return err
+	}
+	var settings evolution.Settings
+	if err := json.Unmarshal(settingsData, &settings); err != nil {
+		return fmt.Errorf("failed to parse settings file: %w", err)
+	}
+	if opts.Workers > 0 {
+		settings.Workers = opts.Workers
+	}
+
+	if err := os.MkdirAll(opts.Output, 0o755); err != nil {
+		return err
+	}
+	generations, err := os.Create(filepath.Join(opts.Output, "generations.jsonl"))
+	if err != nil {
+		return err
+	}
+	defer generations.Close()
+	enc := json.NewEncoder(generations)
+
+	best, err := evolution.Evolve(config, settings, func(g evolution.Generation) error {
+		fmt.Fprintf(os.Stderr, "generation %d: best fitness %.4f, mean fitness %.4f, params %v\n",
+			g.Generation, g.Best.Fitness, g.MeanFitness, g.BestParams)
+		return enc.Encode(g)
+	})
+	if err != nil {
+		return err
+	}
+
+	bestConfig, err := application.MarshalConfig(evolution.Apply(config, settings.Genes, best.Genome))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(opts.Output, "best-config.json"), bestConfig, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "best fitness %.4f, params %v\n", best.Fitness, best.Genome.Named(settings.Genes))
+	return nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"emulation/application"
+	"emulation/generator"
+)
+
+type options struct {
+	Params string  `short:"p" long:"params" default:"generator.json" description:"generator params file"`
+	Seed   *uint64 `short:"s" long:"seed" description:"seed of the economy (default: params file)"`
+	Count  int     `short:"n" long:"count" default:"1" description:"number of economies, generated with the seeds following the first one"`
+	Output string  `short:"o" long:"output" description:"configuration file to write a single economy to (default: stdout), directory for several economies"`
+}
+
+func main() {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+	if opts.Count < 1 {
+		log.Fatalf("count must be positive, got %d", opts.Count)
+	}
+	if opts.Count > 1 && opts.Output == "" {
+		log.Fatalln("several economies need an output directory")
+	}
+
+	paramsData, err := os.ReadFile(opts.Params)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	var params generator.Params
+	if err := json.Unmarshal(paramsData, &params); err != nil {
+		log.Fatalf("failed to parse params file: %v", err)
+	}
+	if opts.Seed != nil {
+		params.Seed = *opts.Seed
+	}
+	if opts.Count > 1 {
+		if err := os.MkdirAll(opts.Output, 0o755); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	for i := range opts.Count {
+		economy := params
+		economy.Seed += uint64(i)
+		config, err := generator.Generate(economy)
+		if err != nil {
+			log.Fatalf("seed %d: %v", economy.Seed, err)
+		}
+		data, err := application.MarshalConfig(config)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		data = append(data, '\n')
+		switch {
+		case opts.Output == "":
+			_, err = os.Stdout.Write(data)
+		case opts.Count == 1:
+			err = os.WriteFile(opts.Output, data, 0o644)
+		default:
+			err = os.WriteFile(filepath.Join(opts.Output, fmt.Sprintf("economy-%d.json", economy.Seed)), data, 0o644)
+		}
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Fprintf(os.Stderr, "seed %d: %d products, %d producers, %d consumers, cycle emission %d\n",
+			economy.Seed, len(config.ProcessSheets), len(config.ProducerConfigs), len(config.Consumers), config.CycleEmission)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"emulation/application"
+	"emulation/planner"
+)
+
+type options struct {
+	Config  string `short:"c" long:"config" default:"config.json" description:"configuration file, its bots play the runs"`
+	Demand  string `short:"d" long:"demand" description:"JSON file with the orders per cycle by the product to plan for (default: the configured consumers)"`
+	Cycles  uint   `short:"n" long:"cycles" default:"100" description:"number of cycles of every run, the horizon of the plan's upgrades"`
+	Warmup  uint   `long:"warmup" default:"20" description:"first cycles of the runs left out of the throughput"`
+	Seeds   int    `long:"seeds" default:"5" description:"number of runs with the consecutive seeds"`
+	Seed    uint64 `short:"s" long:"seed" default:"1" description:"seed of the first run"`
+	Workers int    `short:"w" long:"workers" description:"number of parallel simulations (default: number of CPUs)"`
+	Output  string `short:"o" long:"output" description:"file to write the plan and the regret to (default: stdout)"`
+}
+
+func main() {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+
+	if err := run(opts); err != nil {
+		// the log package is routed to the discarded slog output
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run plans the investments and writes the plan with its regret
+func run(opts options) error {
+	logger := slog.New(slog.DiscardHandler)
+	slog.SetDefault(logger)
+
+	config, err := application.LoadConfig(opts.Config)
+	if err != nil {
+		return err
+	}
+	demand := planner.ConsumerDemand(config)
+	if opts.Demand != "" {
+		data, err := os.ReadFile(opts.Demand)
+		if err != nil {
+			return err
+		}
+		demand = planner.Demand{}
+		if err := json.Unmarshal(data, &demand); err != nil {
+			return fmt.Errorf("failed to parse demand file: %w", err)
+		}
+	}
+
+	plan, err := planner.Solve(config, demand, opts.Cycles)
+	if err != nil {
+		return err
+	}
+	regret, err := planner.CompareRuns(config, plan, planner.RegretOptions{
+		Cycles: opts.Cycles, Warmup: opts.Warmup, Seeds: opts.Seeds, Seed: opts.Seed, Workers: opts.Workers,
+	})
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(regret); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "planned throughput: %.3f of %.3f orders per cycle, runs: %.3f, regret: %.3f (%.1f%%)\n",
+		plan.Throughput, plan.Demand, regret.Throughput, regret.Regret, 100*regret.Relative)
+	return nil
+}
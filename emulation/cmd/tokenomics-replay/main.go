@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"emulation/application"
+	"emulation/domain"
+)
+
+type options struct {
+	Until   uint `short:"u" long:"until" description:"stop the replay at the start of the cycle"`
+	State   bool `long:"state" description:"write the snapshot of the replayed system to stdout"`
+	Verbose bool `short:"v" long:"verbose" description:"log domain events to stderr"`
+	Args    struct {
+		File string `positional-arg-name:"file" required:"yes" description:"command log as JSON lines or a JSON array, - for the standard input"`
+	} `positional-args:"yes"`
+}
+
+// fail reports the error on stderr, the log package is routed to the discarded slog output
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+func main() {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+
+	logger := slog.New(slog.DiscardHandler)
+	var events *domain.EventBus
+	if opts.Verbose {
+		logger = slog.New(slog.NewJSONHandler(os.Stderr, nil))
+		events = domain.NewEventBus()
+		events.Subscribe(domain.LogEvents(logger))
+	}
+	slog.SetDefault(logger)
+
+	var in io.Reader = os.Stdin
+	if opts.Args.File != "-" {
+		f, err := os.Open(opts.Args.File)
+		if err != nil {
+			fail(err)
+		}
+		defer f.Close()
+		in = f
+	}
+	commands, err := application.ReadCommands(in)
+	if err != nil {
+		fail(err)
+	}
+
+	system, results, err := application.Replay(commands, opts.Until, events)
+	if err != nil {
+		fail(fmt.Errorf("%d cycles reproduced, then: %w", len(results), err))
+	}
+	if system == nil {
+		fail(errors.New("empty command log"))
+	}
+
+	if opts.State {
+		snapshot, err := system.Snapshot()
+		if err != nil {
+			fail(err)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(snapshot); err != nil {
+			fail(err)
+		}
+	}
+
+	var score domain.Score
+	for _, r := range results {
+		score += r.Score
+	}
+	fmt.Fprintf(os.Stderr, "commands: %d, cycles reproduced: %d, total score: %d, stopped at cycle %d\n",
+		len(commands), len(results), score, system.GetSystemInfo().CycleCounter)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"emulation/application"
+	"emulation/domain"
+	"emulation/simulation"
+)
+
+type options struct {
+	Config  string `short:"c" long:"config" default:"config.json" description:"configuration file"`
+	Cycles  uint   `short:"n" long:"cycles" default:"100" description:"number of cycles to run"`
+	Seed    uint64 `short:"s" long:"seed" default:"1" description:"seed of the consumers' demand"`
+	Format  string `short:"f" long:"format" default:"json" choice:"json" choice:"csv" description:"format of the per-cycle results"`
+	Output  string `short:"o" long:"output" description:"file to write the per-cycle results to (default: stdout)"`
+	Verbose bool   `short:"v" long:"verbose" description:"log domain events to stderr"`
+}
+
+func main() {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+
+	if err := run(opts); err != nil {
+		// the log package is routed to the discarded slog output
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run plays the simulation and writes its per-cycle results
+func run(opts options) error {
+	logger := slog.New(slog.DiscardHandler)
+	var events *domain.EventBus
+	if opts.Verbose {
+		logger = slog.New(slog.NewJSONHandler(os.Stderr, nil))
+		events = domain.NewEventBus()
+		events.Subscribe(domain.LogEvents(logger))
+	}
+	slog.SetDefault(logger)
+
+	config, err := application.LoadConfig(opts.Config)
+	if err != nil {
+		return err
+	}
+
+	result, err := simulation.Run(config, simulation.Options{Cycles: opts.Cycles, Seed: opts.Seed, Events: events})
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	if opts.Format == "csv" {
+		err = simulation.WriteCSV(out, result.Cycles)
+	} else {
+		err = simulation.WriteJSON(out, result)
+	}
+	if err != nil {
+		return err
+	}
+
+	s := result.Summary
+	fmt.Fprintf(os.Stderr, "cycles: %d, seed: %d, total score: %d, mean score: %.2f, accepted share: %.2f, capacity: %d/%d\n",
+		s.Cycles, s.Seed, s.TotalScore, s.MeanScore, s.AcceptedShare, s.FinalCapacity, s.FinalMaxCapacity)
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"emulation/application"
+	"emulation/experiment"
+)
+
+type options struct {
+	Config  string `short:"c" long:"config" default:"config.json" description:"base configuration file"`
+	Sweep   string `short:"s" long:"sweep" default:"sweep.json" description:"sweep file with the parameter ranges and the number of seeds"`
+	Output  string `short:"o" long:"output" description:"CSV file to write the aggregated metrics to (default: stdout)"`
+	Workers int    `short:"w" long:"workers" description:"number of parallel simulations (default: sweep file or number of CPUs)"`
+}
+
+func main() {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+
+	if err := run(opts); err != nil {
+		// the log package is routed to the discarded slog output
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run sweeps the parameters and writes the points
+func run(opts options) error {
+	logger := slog.New(slog.DiscardHandler)
+	slog.SetDefault(logger)
+
+	config, err := application.LoadConfig(opts.Config)
+	if err != nil {
+		return err
+	}
+	sweepData, err := os.ReadFile(opts.Sweep)
+	if err != nil {
+		return err
+	}
+	var sweep experiment.Sweep
+	if err := json.Unmarshal(sweepData, &sweep); err != nil {
+		return fmt.Errorf("failed to parse sweep file: %w", err)
+	}
+	if opts.Workers > 0 {
+		sweep.Workers = opts.Workers
+	}
+
+	points, err := experiment.Run(config, sweep)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := experiment.WriteCSV(out, sweep, points); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "points: %d, runs per point: %d\n", len(points), sweep.Seeds)
+	return nil
+}
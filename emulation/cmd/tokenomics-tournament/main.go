@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"emulation/application"
+	"emulation/tournament"
+)
+
+type options struct {
+	Config     string `short:"c" long:"config" default:"config.json" description:"base configuration file"`
+	Tournament string `short:"t" long:"tournament" default:"tournament.json" description:"tournament file with the format, the entrants and the number of seeds"`
+	Format     string `short:"f" long:"format" default:"csv" choice:"json" choice:"csv" description:"format of the results: the leaderboard in CSV or the leaderboard with all matches in JSON"`
+	Output     string `short:"o" long:"output" description:"file to write the results to (default: stdout)"`
+	Workers    int    `short:"w" long:"workers" description:"number of parallel simulations (default: tournament file or number of CPUs)"`
+}
+
+func main() {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		code := 1
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			code = 0
+		}
+		os.Exit(code)
+	}
+
+	if err := run(opts); err != nil {
+		// the log package is routed to the discarded slog output
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run plays the tournament and writes its results
+func run(opts options) error {
+	logger := slog.New(slog.DiscardHandler)
+	slog.SetDefault(logger)
+
+	config, err := application.LoadConfig(opts.Config)
+	if err != nil {
+		return err
+	}
+	settingsData, err := os.ReadFile(opts.Tournament)
+	if err != nil {
+		return err
+	}
+	var settings tournament.Settings
+	if err := json.Unmarshal(settingsData, &settings); err != nil {
+		return fmt.Errorf("failed to parse tournament file: %w", err)
+	}
+	if opts.Workers > 0 {
+		settings.Workers = opts.Workers
+	}
+
+	result, err := tournament.Run(config, settings)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	switch opts.Format {
+	case "csv":
+		err = tournament.WriteCSV(out, result.Leaderboard)
+	default:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(result)
+	}
+	if err != nil {
+		return err
+	}
+	winner := result.Leaderboard[0]
+	fmt.Fprintf(os.Stderr, "matches: %d, winner: %s (%g points)\n", len(result.Matches), winner.Entrant, winner.Points)
+	return nil
+}
@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Codes of the analysis findings
+const (
+	FindingInvalid            = "invalid"
+	FindingUnknownProduct     = "unknown-product"
+	FindingUnreachableProduct = "unreachable-product"
+	FindingUnusedProduct      = "unused-product"
+	FindingUnusedCapacity     = "unused-capacity"
+	FindingInvestmentCycle    = "investment-cycle"
+	FindingNoRestoration      = "no-restoration"
+	FindingRestorationDeficit = "restoration-deficit"
+	FindingCapacityDeficit    = "capacity-deficit"
+)
+
+// Finding is a problem of the configuration. Subject is the product, the capacity type or the agent it is about.
+type Finding struct {
+	Code    string `json:"code"`
+	Subject string `json:"subject,omitempty"`
+	Message string `json:"message"`
+}
+
+// Analysis of a configuration: the errors make it unplayable, the warnings make it degrade or waste capacity.
+// Demand and Supply are the steady-state capacities per cycle by the capacity type: the consumers' orders and
+// the restorations making up for the degradation against the initial capacities of the producers.
+type Analysis struct {
+	Errors   []Finding                 `json:"errors"`
+	Warnings []Finding                 `json:"warnings"`
+	Demand   map[CapacityType]float64  `json:"demand,omitempty"`
+	Supply   map[CapacityType]Capacity `json:"supply,omitempty"`
+}
+
+// Err joins the errors of the analysis, nil when there are none
+func (a Analysis) Err() error {
+	errs := make([]error, len(a.Errors))
+	for i, f := range a.Errors {
+		errs[i] = errors.New(f.Message)
+	}
+	return errors.Join(errs...)
+}
+
+func (a *Analysis) error(code, subject, format string, args ...any) {
+	a.Errors = append(a.Errors, Finding{code, subject, fmt.Sprintf(format, args...)})
+}
+
+func (a *Analysis) warn(code, subject, format string, args ...any) {
+	a.Warnings = append(a.Warnings, Finding{code, subject, fmt.Sprintf(format, args...)})
+}
+
+// Analyze builds the dependency graph of the capacity types, the products and the investments and reports
+// the products which can't be produced, the capacity types restoring each other and the capacity deficits.
+// The dependencies aren't analysed when the structure of the configuration is invalid.
+func (c *Configuration) Analyze() Analysis {
+	result := Analysis{Errors: []Finding{}, Warnings: []Finding{}}
+	if err := c.validateStructure(); err != nil {
+		result.error(FindingInvalid, "", "%s", err)
+		return result
+	}
+
+	sheets := make(map[Product]ProcessSheet, len(c.ProcessSheets))
+	required := map[CapacityType]bool{}
+	for _, sheet := range c.ProcessSheets {
+		sheets[sheet.Product] = sheet
+		for capType := range sheet.Require {
+			required[capType] = true
+		}
+	}
+	result.Supply = map[CapacityType]Capacity{}
+	for _, producer := range c.ProducerConfigs {
+		result.Supply[producer.Type] += producer.Capacity
+	}
+
+	// products are ordered by the consumers and by the investments of the producers
+	ordered := map[Product]bool{}
+	for _, consumer := range c.Consumers {
+		for _, product := range consumer.Products {
+			if _, ok := sheets[product]; !ok {
+				result.error(FindingUnknownProduct, string(consumer.Id), "consumer %s orders product %v which has no process sheet", consumer.Id, product)
+			}
+			ordered[product] = true
+		}
+	}
+	for _, producer := range c.ProducerConfigs {
+		for _, investment := range []struct {
+			name    string
+			product Product
+			amount  Capacity
+		}{
+			{"restoration", producer.Restoration.Require, producer.Restoration.Restores},
+			{"upgrade", producer.Upgrade.Require, producer.Upgrade.Increases},
+		} {
+			if investment.amount == 0 {
+				continue
+			}
+			if _, ok := sheets[investment.product]; !ok {
+				result.error(FindingUnknownProduct, string(producer.Id), "producer %s %s requires product %v which has no process sheet", producer.Id, investment.name, investment.product)
+			}
+			ordered[investment.product] = true
+		}
+		if !required[producer.Type] {
+			result.warn(FindingUnusedCapacity, string(producer.Id), "producer %s provides capacity type %s which no process sheet requires", producer.Id, producer.Type)
+		}
+	}
+
+	for _, sheet := range c.ProcessSheets {
+		for _, capType := range slices.Sorted(maps.Keys(sheet.Require)) {
+			if _, ok := result.Supply[capType]; !ok {
+				result.error(FindingUnreachableProduct, fmt.Sprint(sheet.Product), "product %v requires capacity type %s which no producer provides", sheet.Product, capType)
+			}
+		}
+		if !ordered[sheet.Product] {
+			result.warn(FindingUnusedProduct, fmt.Sprint(sheet.Product), "product %v is ordered neither by the consumers nor by the investments", sheet.Product)
+		}
+	}
+	if len(result.Errors) > 0 {
+		return result
+	}
+
+	c.analyzeRestorations(&result, sheets)
+	c.analyzeDemand(&result, sheets)
+	return result
+}
+
+// analyzeRestorations reports the producers degrading faster than they restore and the capacity types
+// which are restored only with the products requiring themselves: exhausted together, they are never restored
+func (c *Configuration) analyzeRestorations(result *Analysis, sheets map[Product]ProcessSheet) {
+	restoredWith := map[CapacityType]map[CapacityType]bool{}
+	for _, producer := range c.ProducerConfigs {
+		degradation := degradationOf(producer)
+		switch {
+		case degradation > 0 && producer.Restoration.Restores == 0:
+			result.warn(FindingNoRestoration, string(producer.Id), "producer %s degrades by %d per cycle and has no restoration", producer.Id, degradation)
+		case producer.Restoration.Restores < degradation:
+			result.warn(FindingRestorationDeficit, string(producer.Id), "producer %s degrades by %d per cycle, faster than its restoration of %d per cycle at most", producer.Id, degradation, producer.Restoration.Restores)
+		}
+		if producer.Restoration.Restores == 0 {
+			continue
+		}
+		if restoredWith[producer.Type] == nil {
+			restoredWith[producer.Type] = map[CapacityType]bool{}
+		}
+		for capType := range sheets[producer.Restoration.Require].Require {
+			restoredWith[producer.Type][capType] = true
+		}
+	}
+
+	for _, component := range stronglyConnected(restoredWith) {
+		if len(component) == 1 && !restoredWith[component[0]][component[0]] {
+			continue
+		}
+		result.warn(FindingInvestmentCycle, fmt.Sprint(component), "capacity types %v need each other's capacity to be restored, exhausted together they are never restored", component)
+	}
+}
+
+// analyzeDemand compares the steady-state demand of every capacity type with its supply
+func (c *Configuration) analyzeDemand(result *Analysis, sheets map[Product]ProcessSheet) {
+	result.Demand = map[CapacityType]float64{}
+	// every consumer orders one of its products a cycle, chosen uniformly
+	for _, consumer := range c.Consumers {
+		for _, product := range consumer.Products {
+			for capType, capacity := range sheets[product].Require {
+				result.Demand[capType] += float64(capacity) / float64(len(consumer.Products))
+			}
+		}
+	}
+	// the restorations keep up with the degradation, one restoration a cycle at most
+	for _, producer := range c.ProducerConfigs {
+		degradation := degradationOf(producer)
+		if degradation == 0 || producer.Restoration.Restores == 0 {
+			continue
+		}
+		rate := min(1, float64(degradation)/float64(producer.Restoration.Restores))
+		for capType, capacity := range sheets[producer.Restoration.Require].Require {
+			result.Demand[capType] += rate * float64(capacity)
+		}
+	}
+
+	for _, capType := range slices.Sorted(maps.Keys(result.Demand)) {
+		if demand := result.Demand[capType]; demand > float64(result.Supply[capType]) {
+			result.warn(FindingCapacityDeficit, string(capType), "capacity type %s: steady-state demand of %.1f per cycle exceeds the supply of %d", capType, demand, result.Supply[capType])
+		}
+	}
+}
+
+// degradationOf is the capacity the producer loses every cycle at its initial capacity
+func degradationOf(producer ProducingAgentConfig) Capacity {
+	p := ProducingAgent{degradation: producer.Degradation, producerState: producerState{maxCapacity: producer.Capacity}}
+	return p.capacityDegradation()
+}
+
+// stronglyConnected returns the strongly connected components of the graph, each sorted, in the order of their first nodes
+func stronglyConnected(graph map[CapacityType]map[CapacityType]bool) [][]CapacityType {
+	index := map[CapacityType]int{}
+	low := map[CapacityType]int{}
+	onStack := map[CapacityType]bool{}
+	var stack []CapacityType
+	var result [][]CapacityType
+
+	var visit func(v CapacityType)
+	visit = func(v CapacityType) {
+		index[v], low[v] = len(index), len(index)
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range slices.Sorted(maps.Keys(graph[v])) {
+			if _, visited := index[w]; !visited {
+				visit(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+		if low[v] != index[v] {
+			return
+		}
+		var component []CapacityType
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		slices.Sort(component)
+		result = append(result, component)
+	}
+	for _, v := range slices.Sorted(maps.Keys(graph)) {
+		if _, visited := index[v]; !visited {
+			visit(v)
+		}
+	}
+	slices.SortFunc(result, func(a, b []CapacityType) int {
+		return cmp.Compare(a[0], b[0])
+	})
+	return result
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	// economyConfig has two capacity types, product 1 needs both and is ordered by the consumer,
+	// product 2 needs type "b" only and restores the producers
+	economyConfig := func() *Configuration {
+		return &Configuration{
+			CycleEmission: 100,
+			ProcessSheets: []ProcessSheet{
+				{Product: 1, Require: map[CapacityType]Capacity{"a": 10, "b": 20}},
+				{Product: 2, Require: map[CapacityType]Capacity{"b": 5}},
+			},
+			ProducerConfigs: []ProducingAgentConfig{
+				{Id: "pa", Type: "a", Capacity: 100, Degradation: 10, Restoration: Restoration{Require: 2, Restores: 20}},
+				{Id: "pb", Type: "b", Capacity: 100, Degradation: 10, Restoration: Restoration{Require: 2, Restores: 20}},
+			},
+			Consumers: []ConsumerConfig{{Id: "c1", Products: []Product{1}}},
+		}
+	}
+	codes := func(findings []Finding) []string {
+		return lo.Map(findings, func(f Finding, _ int) string { return f.Code + " " + f.Subject })
+	}
+
+	t.Run(`Given a feasible economy where type "b" is restored with its own capacity
+		When it is analysed
+		Then the self-dependency is a warning and the steady-state demand is found`, func(t *testing.T) {
+
+		analysis := economyConfig().Analyze()
+		require.Empty(t, analysis.Errors)
+		require.Equal(t, []string{"investment-cycle [b]"}, codes(analysis.Warnings))
+		// the consumer's product and a half restoration a cycle of both producers
+		require.Equal(t, map[CapacityType]float64{"a": 10, "b": 20 + 2*0.5*5}, analysis.Demand)
+		require.Equal(t, map[CapacityType]Capacity{"a": 100, "b": 100}, analysis.Supply)
+		require.NoError(t, economyConfig().Validate())
+	})
+
+	t.Run(`Given an economy with degrading producers without restorations, an unused product and producer
+		and a demand above the supply
+		When it is analysed
+		Then every problem is a warning`, func(t *testing.T) {
+
+		config := economyConfig()
+		config.ProcessSheets = append(config.ProcessSheets, ProcessSheet{Product: 3, Require: map[CapacityType]Capacity{"a": 1}})
+		config.ProcessSheets[0].Require["a"] = 150
+		config.ProducerConfigs[0].Restoration = Restoration{}
+		config.ProducerConfigs[1].Restoration = Restoration{Require: 2, Restores: 5}
+		config.ProducerConfigs = append(config.ProducerConfigs, ProducingAgentConfig{Id: "pc", Type: "c", Capacity: 10})
+
+		analysis := config.Analyze()
+		require.Empty(t, analysis.Errors)
+		require.Equal(t, []string{
+			"unused-capacity pc",
+			"unused-product 3",
+			"no-restoration pa",
+			"restoration-deficit pb",
+			"investment-cycle [b]",
+			"capacity-deficit a",
+		}, codes(analysis.Warnings))
+		require.NoError(t, config.Validate())
+	})
+
+	t.Run(`Given an economy with a product of a capacity type without producers and an investment in an unknown product
+		When it is analysed and validated
+		Then both are errors`, func(t *testing.T) {
+
+		config := economyConfig()
+		config.ProcessSheets[0].Require["x"] = 1
+		config.ProducerConfigs[0].Upgrade = Upgrade{Require: 7, Increases: 10}
+
+		analysis := config.Analyze()
+		require.Equal(t, []string{"unknown-product pa", "unreachable-product 1"}, codes(analysis.Errors))
+		require.ErrorContains(t, config.Validate(), "producer pa upgrade requires product 7 which has no process sheet")
+		require.ErrorContains(t, config.Validate(), "product 1 requires capacity type x which no producer provides")
+	})
+
+	t.Run(`Given a structurally invalid configuration
+		When it is analysed
+		Then the structural error is the only finding`, func(t *testing.T) {
+
+		config := economyConfig()
+		config.CycleEmission = 0
+
+		analysis := config.Analyze()
+		require.Equal(t, []string{"invalid "}, codes(analysis.Errors))
+		require.EqualError(t, config.Validate(), "cycle emission must be positive, got 0")
+	})
+}
@@ -10,21 +10,65 @@ type ProcessSheet struct {
 	Require map[CapacityType]Capacity `json:"require"`
 }
 
+// StrategyConfig selects a built-in agent strategy by name and tunes it with named parameters
+type StrategyConfig struct {
+	Name   string             `json:"name"`
+	Params map[string]float64 `json:"params,omitempty"`
+}
+
+const (
+	DefaultActionAbstain  = "abstain"
+	DefaultActionRepeat   = "repeat"
+	DefaultActionStrategy = "strategy"
+)
+
+// PhaseDeadline ends a phase after the timeout and/or as soon as the last pending agent has submitted its command.
+// A phase without a deadline ends only when the next phase is started explicitly.
+type PhaseDeadline struct {
+	TimeoutMs    uint `json:"timeoutMs,omitempty"`
+	AllSubmitted bool `json:"allSubmitted,omitempty"`
+}
+
+// DefaultAction is taken for an agent which missed the deadline: it abstains (the default),
+// repeats its last command or is commanded by the built-in strategy
+type DefaultAction struct {
+	Kind     string          `json:"kind,omitempty"`
+	Strategy *StrategyConfig `json:"strategy,omitempty"`
+}
+
+// Deadlines of the investment phase (producing agents) and the ordering phase (ordering agents)
+type Deadlines struct {
+	Investment       PhaseDeadline `json:"investment"`
+	Ordering         PhaseDeadline `json:"ordering"`
+	DefaultProducing DefaultAction `json:"defaultProducing"`
+	DefaultOrdering  DefaultAction `json:"defaultOrdering"`
+}
+
 // Configuration represents the system configuration
 type Configuration struct {
-	CycleEmission   Tokens                 `json:"cycleEmission"`
-	ProcessSheets   []ProcessSheet         `json:"processSheets"`
-	ProducerConfigs []ProducingAgentConfig `json:"producerConfigs"`
+	CycleEmission   Tokens                             `json:"cycleEmission"`
+	ProcessSheets   []ProcessSheet                     `json:"processSheets"`
+	ProducerConfigs []ProducingAgentConfig             `json:"producerConfigs"`
+	Consumers       []ConsumerConfig                   `json:"consumers,omitempty"`
+	OrderingBots    map[OrderingAgentId]StrategyConfig `json:"orderingBots,omitempty"`
+	ProducingBots   map[ProducerId]StrategyConfig      `json:"producingBots,omitempty"`
+	Deadlines       Deadlines                          `json:"deadlines,omitzero"`
 }
 
+// Validate checks the structure of the configuration and the errors of its analysis, the warnings are ignored
 func (c *Configuration) Validate() error {
+	return c.Analyze().Err()
+}
+
+// validateStructure checks the identifiers, the references to the agents and the positivity of the values,
+// the dependencies between the products, the capacities and the investments are checked by Analyze
+func (c *Configuration) validateStructure() error {
 	if c.CycleEmission <= 0 {
 		return fmt.Errorf("cycle emission must be positive, got %d", c.CycleEmission)
 	}
 
 	// Validate process sheets
 	processProducts := make(map[Product]bool)
-	processCapacities := make(map[CapacityType]bool)
 	for _, sheet := range c.ProcessSheets {
 		if len(sheet.Require) == 0 {
 			return fmt.Errorf("process sheet for product %v has no capacity requirements", sheet.Product)
@@ -38,13 +82,11 @@ func (c *Configuration) Validate() error {
 			if cap <= 0 {
 				return fmt.Errorf("capacity requirement must be positive, got %d for type %s", cap, capType)
 			}
-			processCapacities[capType] = true
 		}
 	}
 
 	// Validate producer configs
 	producerIds := make(map[ProducerId]bool)
-	producerCapTypes := make(map[CapacityType]bool)
 	for _, config := range c.ProducerConfigs {
 		if producerIds[config.Id] {
 			return fmt.Errorf("duplicate producer id %s", config.Id)
@@ -54,14 +96,53 @@ func (c *Configuration) Validate() error {
 		if config.Capacity <= 0 {
 			return fmt.Errorf("producer %s capacity must be positive, got %d", config.Id, config.Capacity)
 		}
+	}
+
+	// Validate consumers
+	consumerIds := make(map[ConsumerId]bool)
+	for _, consumer := range c.Consumers {
+		if consumerIds[consumer.Id] {
+			return fmt.Errorf("duplicate consumer id %s", consumer.Id)
+		}
+		if producerIds[ProducerId(consumer.Id)] {
+			return fmt.Errorf("consumer id %s clashes with a producer id", consumer.Id)
+		}
+		consumerIds[consumer.Id] = true
 
-		producerCapTypes[config.Type] = true
+		if len(consumer.Products) == 0 {
+			return fmt.Errorf("consumer %s has no products to order", consumer.Id)
+		}
 	}
 
-	// Cross-validate process sheets and producers
-	for capType := range processCapacities {
-		if !producerCapTypes[capType] {
-			return fmt.Errorf("capacity type %s required by process sheets but no producer provides it", capType)
+	// Validate bots
+	for agentId, strategy := range c.OrderingBots {
+		if !producerIds[ProducerId(agentId)] && !consumerIds[ConsumerId(agentId)] {
+			return fmt.Errorf("ordering bot %s is neither a producer nor a consumer", agentId)
+		}
+		if strategy.Name == "" {
+			return fmt.Errorf("ordering bot %s has no strategy name", agentId)
+		}
+	}
+
+	for producerId, strategy := range c.ProducingBots {
+		if !producerIds[producerId] {
+			return fmt.Errorf("producing bot %s is not a configured producer", producerId)
+		}
+		if strategy.Name == "" {
+			return fmt.Errorf("producing bot %s has no strategy name", producerId)
+		}
+	}
+
+	// Validate deadlines
+	for _, action := range []DefaultAction{c.Deadlines.DefaultProducing, c.Deadlines.DefaultOrdering} {
+		switch action.Kind {
+		case "", DefaultActionAbstain, DefaultActionRepeat:
+		case DefaultActionStrategy:
+			if action.Strategy == nil || action.Strategy.Name == "" {
+				return fmt.Errorf("default action has no strategy name")
+			}
+		default:
+			return fmt.Errorf("unknown default action %s", action.Kind)
 		}
 	}
 
@@ -1,11 +1,13 @@
 package domain
 
+import "math/rand/v2"
+
 type ConsumerId string
 
 type ConsumerRequest struct {
-	ConsumerId ConsumerId
-	Product    Product
-	Tokens     Tokens
+	ConsumerId ConsumerId `json:"consumerId"`
+	Product    Product    `json:"product"`
+	Tokens     Tokens     `json:"tokens"`
 }
 
 type Consumer interface {
@@ -13,3 +15,55 @@ type Consumer interface {
 	Order() []ConsumerRequest
 	Emit(Tokens)
 }
+
+// ConsumerConfig describes a final consumer and the products it may order
+type ConsumerConfig struct {
+	Id       ConsumerId `json:"id"`
+	Products []Product  `json:"products"`
+}
+
+// RandomConsumer spends all its tokens each cycle on a product randomly chosen among the configured ones
+type RandomConsumer struct {
+	id       ConsumerId
+	products []Product
+	tokens   Tokens
+	// src is kept for the snapshots
+	src *rand.PCG
+	rnd *rand.Rand
+}
+
+func NewRandomConsumer(config ConsumerConfig, src *rand.PCG) *RandomConsumer {
+	return &RandomConsumer{config.Id, config.Products, 0, src, rand.New(src)}
+}
+
+// NewConsumers creates the configured consumers. Each consumer gets its own random source derived from the seed,
+// so the same seed always produces the same demand.
+func NewConsumers(configs []ConsumerConfig, seed uint64) map[ConsumerId]Consumer {
+	result := make(map[ConsumerId]Consumer, len(configs))
+	for i, config := range configs {
+		result[config.Id] = NewRandomConsumer(config, rand.NewPCG(seed, uint64(i)))
+	}
+	return result
+}
+
+// Id implements Consumer.
+func (c *RandomConsumer) Id() ConsumerId {
+	return c.id
+}
+
+// Emit implements Consumer.
+func (c *RandomConsumer) Emit(tokens Tokens) {
+	c.tokens += tokens
+}
+
+// Order implements Consumer.
+func (c *RandomConsumer) Order() []ConsumerRequest {
+	if c.tokens == 0 || len(c.products) == 0 {
+		return nil
+	}
+	request := ConsumerRequest{c.id, c.products[c.rnd.IntN(len(c.products))], c.tokens}
+	c.tokens = 0
+	return []ConsumerRequest{request}
+}
+
+var _ SnapshotConsumer = &RandomConsumer{}
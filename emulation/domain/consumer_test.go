@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomConsumer(t *testing.T) {
+	configs := []ConsumerConfig{
+		{"c1", []Product{1, 2, 3}},
+		{"c2", []Product{1, 2, 3}},
+	}
+	orders := func(seed uint64) []ConsumerRequest {
+		consumers := NewConsumers(configs, seed)
+		result := []ConsumerRequest{}
+		for range 10 {
+			for _, id := range []ConsumerId{"c1", "c2"} {
+				consumers[id].Emit(10)
+				result = append(result, consumers[id].Order()...)
+			}
+		}
+		return result
+	}
+
+	t.Run(`Given consumers created with the same seed
+		When they order several times
+		Then they order the same products`, func(t *testing.T) {
+
+		require.Equal(t, orders(42), orders(42))
+	})
+
+	t.Run(`Given a consumer with emitted tokens
+		When it orders
+		Then all its tokens are spent on a single configured product`, func(t *testing.T) {
+
+		consumer := NewConsumers(configs[:1], 1)["c1"]
+		consumer.Emit(10)
+		consumer.Emit(5)
+		requests := consumer.Order()
+		require.Len(t, requests, 1)
+		require.Equal(t, ConsumerId("c1"), requests[0].ConsumerId)
+		require.Equal(t, Tokens(15), requests[0].Tokens)
+		require.Contains(t, configs[0].Products, requests[0].Product)
+
+		require.Empty(t, consumer.Order())
+	})
+}
@@ -0,0 +1,25 @@
+// Package domaintest provides the configurations shared by the tests of the packages running simulations
+package domaintest
+
+import "emulation/domain"
+
+// Economy is a small economy without bots: two products needing both capacity types,
+// two restoring producers, one of each type, and two consumers ordering both products.
+// Every call returns a new configuration, so the tests can change it.
+func Economy() *domain.Configuration {
+	return &domain.Configuration{
+		CycleEmission: 1000,
+		ProcessSheets: []domain.ProcessSheet{
+			{Product: 1, Require: map[domain.CapacityType]domain.Capacity{"1": 15, "2": 8}},
+			{Product: 2, Require: map[domain.CapacityType]domain.Capacity{"1": 40, "2": 60}},
+		},
+		ProducerConfigs: []domain.ProducingAgentConfig{
+			{Id: "p1", Type: "1", Capacity: 100, Degradation: 5, Restoration: domain.Restoration{Require: 2, Restores: 20}},
+			{Id: "p2", Type: "2", Capacity: 80, Degradation: 5, Restoration: domain.Restoration{Require: 1, Restores: 20}},
+		},
+		Consumers: []domain.ConsumerConfig{
+			{Id: "c1", Products: []domain.Product{1, 2}},
+			{Id: "c2", Products: []domain.Product{1, 2}},
+		},
+	}
+}
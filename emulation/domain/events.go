@@ -0,0 +1,207 @@
+package domain
+
+import "sync"
+
+// Event is a typed change of the system state published on the EventBus
+type Event interface {
+	EventName() string
+}
+
+// OrderCreated is a new consumer or investment order, the other request's id is empty
+type OrderCreated struct {
+	OrderId    OrderId
+	ConsumerId ConsumerId
+	ProducerId ProducerId
+	Product    Product
+	Tokens     Tokens
+}
+
+// InvestmentFunded is an investment order funded from the investment fund
+type InvestmentFunded struct {
+	OrderId     OrderId
+	ProducerId  ProducerId
+	Tokens      Tokens
+	CutOffPrice CapacityUnitPrice
+}
+
+// OrderPlaced is a funded order passed to its ordering agent with the capacities still required
+type OrderPlaced struct {
+	AgentId  OrderingAgentId
+	OrderId  OrderId
+	Tokens   Tokens
+	Required map[CapacityType]Capacity
+}
+
+// BidPlaced is an ordering agent's bid for a part of an order
+type BidPlaced struct {
+	AgentId      OrderingAgentId
+	OrderId      OrderId
+	ProducerId   ProducerId
+	CapacityType CapacityType
+	Capacity     Capacity
+	Tokens       Tokens
+}
+
+// AgentPassed is an ordering agent's turn ended without bids
+type AgentPassed struct {
+	AgentId OrderingAgentId
+	Orders  int
+}
+
+// InvestmentRequested is an upgrade or a restoration requested by a producer
+type InvestmentRequested struct {
+	ProducerId ProducerId
+	Type       InvestmentType
+	Product    Product
+	Capacity   Capacity
+}
+
+// InvestmentCompleted is an upgrade or a restoration applied to a producer
+type InvestmentCompleted struct {
+	ProducerId  ProducerId
+	Type        InvestmentType
+	Capacity    Capacity
+	MaxCapacity Capacity
+}
+
+// InvestmentRejected is an upgrade or a restoration which order failed
+type InvestmentRejected struct {
+	ProducerId ProducerId
+	Type       InvestmentType
+}
+
+// ProductionCompleted is the outcome of a producer's auction
+type ProductionCompleted MarketRecord
+
+// PartProcessing is a part of an order booked by a producer beyond the cycle
+type PartProcessing struct {
+	OrderId      OrderId
+	CapacityType CapacityType
+	Tokens       Tokens
+}
+
+// PartCompleted is a part of an order produced
+type PartCompleted struct {
+	OrderId      OrderId
+	CapacityType CapacityType
+	Tokens       Tokens
+}
+
+// PartRejected is a part of an order refused by a producer
+type PartRejected struct {
+	OrderId      OrderId
+	CapacityType CapacityType
+}
+
+// PartDropped is the work on a part of an order which has already timed out
+type PartDropped struct {
+	OrderId      OrderId
+	CapacityType CapacityType
+}
+
+// OrderCompleted is an order with all parts produced
+type OrderCompleted struct {
+	OrderId OrderId
+	AgentId OrderingAgentId
+	Score   Score
+}
+
+// OrderRejected is an order with all parts rejected or timed out, the higher score tells the timeout.
+// The remaining tokens of a consumer order return to the consumer.
+type OrderRejected struct {
+	OrderId   OrderId
+	AgentId   OrderingAgentId
+	Score     Score
+	Remaining Tokens
+}
+
+// CycleStarted is a new cycle with the tokens emitted
+type CycleStarted struct {
+	Cycle          uint
+	Emission       Tokens
+	InvestmentFund Tokens
+	ConsumerTokens Tokens
+}
+
+// OrderingStarted is the ordering phase with the investment fund distributed
+type OrderingStarted struct {
+	Cycle  uint
+	Orders int
+	// Remainder is the part of the investment fund left undistributed
+	Remainder Tokens
+}
+
+// CycleCompleted is the end of a cycle, Completed and Rejected count the finished requests by the ordering agents
+type CycleCompleted struct {
+	Cycle     uint
+	Score     Score
+	Completed map[OrderingAgentId]uint
+	Rejected  map[OrderingAgentId]uint
+}
+
+func (OrderCreated) EventName() string        { return "order.created" }
+func (InvestmentFunded) EventName() string    { return "order.funded" }
+func (OrderPlaced) EventName() string         { return "ordering.order.placed" }
+func (BidPlaced) EventName() string           { return "ordering.bid.placed" }
+func (AgentPassed) EventName() string         { return "ordering.command.passed" }
+func (InvestmentRequested) EventName() string { return "producer.investment.requested" }
+func (InvestmentCompleted) EventName() string { return "producer.investment.completed" }
+func (InvestmentRejected) EventName() string  { return "producer.investment.rejected" }
+func (ProductionCompleted) EventName() string { return "producer.production.completed" }
+func (PartProcessing) EventName() string      { return "order.part.processing" }
+func (PartCompleted) EventName() string       { return "order.part.completed" }
+func (PartRejected) EventName() string        { return "order.part.rejected" }
+func (PartDropped) EventName() string         { return "order.part.dropped" }
+func (OrderCompleted) EventName() string      { return "order.completed" }
+func (OrderRejected) EventName() string       { return "order.rejected" }
+func (CycleStarted) EventName() string        { return "system.cycle.started" }
+func (OrderingStarted) EventName() string     { return "system.ordering.started" }
+func (CycleCompleted) EventName() string      { return "system.cycle.completed" }
+
+type subscriber struct {
+	id      int
+	handler func(Event)
+}
+
+// EventBus delivers the events to the subscribers synchronously in the order of the subscription.
+// A nil bus drops the events.
+type EventBus struct {
+	mu          sync.RWMutex
+	subscribers []subscriber
+	last        int
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{}
+}
+
+// Subscribe adds the handler, it is called on the publisher's goroutine and must not publish itself
+func (b *EventBus) Subscribe(handler func(Event)) (unsubscribe func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.last++
+	id := b.last
+	b.subscribers = append(b.subscribers, subscriber{id, handler})
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for i, s := range b.subscribers {
+			if s.id == id {
+				b.subscribers = append(b.subscribers[:i:i], b.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func (b *EventBus) Publish(e Event) {
+	if b == nil {
+		return
+	}
+	b.mu.RLock()
+	subscribers := b.subscribers
+	b.mu.RUnlock()
+	for _, s := range subscribers {
+		s.handler(e)
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBus(t *testing.T) {
+	cfg := setupTestConfig()
+
+	t.Run(`Given the system with an events subscriber
+		When a producer requests an upgrade
+		And a consumer order is produced in a cycle
+		Then the state changes are published as typed events in order`, func(t *testing.T) {
+
+		consumer := TestConsumer{id: "c1", products: []Product{cfg.consumerProduct}}
+		bus := NewEventBus()
+		var events []Event
+		bus.Subscribe(func(e Event) { events = append(events, e) })
+
+		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{"c1": &consumer}, bus)
+		require.NoError(t, system.ProducingAgentAction("p1", ProducingAgentCommand{DoUpgrade: true}))
+		require.NoError(t, system.StartOrdering())
+		require.NoError(t, system.OrderingAgentAction("c1", OrderingAgentCommand{
+			Orders: map[OrderId]map[ProducerId]Tokens{"0": {"p1": 50}}}))
+		require.NoError(t, system.OrderingAgentPass("p1"))
+		_, err := system.CompleteCycle()
+		require.NoError(t, err)
+
+		require.Empty(t, cmp.Diff([]Event{
+			CycleStarted{1, 100, 50, 50},
+			OrderCreated{OrderId: "0", ConsumerId: "c1", Product: cfg.consumerProduct, Tokens: 50},
+			InvestmentRequested{"p1", InvestmentTypeUpgrade, 2, 50},
+			OrderCreated{OrderId: "1", ProducerId: "p1", Product: 2},
+			InvestmentFunded{"1", "p1", 50, UndefinedPrice},
+			OrderPlaced{"c1", "0", 50, map[CapacityType]Capacity{cfg.cpt1: 10}},
+			OrderPlaced{"p1", "1", 50, map[CapacityType]Capacity{cfg.cpt2: 200}},
+			OrderingStarted{1, 2, 0},
+			BidPlaced{"c1", "0", "p1", cfg.cpt1, 10, 50},
+			AgentPassed{"p1", 1},
+			ProductionCompleted{1, "p1", cfg.cpt1, 5, 100, 10, 10, 0, 50},
+			PartCompleted{"0", cfg.cpt1, 50},
+			ProductionCompleted{1, "p2", cfg.cpt2, UndefinedPrice, 110, 0, 0, 0, 0},
+			OrderCompleted{"0", "c1", 0},
+			CycleCompleted{1, 1, map[OrderingAgentId]uint{"c1": 1}, map[OrderingAgentId]uint{}},
+			CycleStarted{2, 100, 50, 50},
+			OrderCreated{OrderId: "2", ConsumerId: "c1", Product: cfg.consumerProduct, Tokens: 50},
+		}, events))
+	})
+
+	t.Run(`Given a bus with subscribers
+		When one of them unsubscribes
+		Then only the rest receive the further events`, func(t *testing.T) {
+
+		bus := NewEventBus()
+		var first, second []Event
+		unsubscribe := bus.Subscribe(func(e Event) { first = append(first, e) })
+		bus.Subscribe(func(e Event) { second = append(second, e) })
+
+		bus.Publish(CycleCompleted{Cycle: 1})
+		unsubscribe()
+		bus.Publish(CycleCompleted{Cycle: 2})
+
+		require.Equal(t, []Event{CycleCompleted{Cycle: 1}}, first)
+		require.Equal(t, []Event{CycleCompleted{Cycle: 1}, CycleCompleted{Cycle: 2}}, second)
+	})
+
+	t.Run(`Given the logging subscriber
+		When an event is published
+		Then it is logged by name with the fields as attributes`, func(t *testing.T) {
+
+		var buf bytes.Buffer
+		bus := NewEventBus()
+		bus.Subscribe(LogEvents(slog.New(slog.NewJSONHandler(&buf, nil))))
+
+		bus.Publish(InvestmentFunded{"1", "p1", 50, UndefinedPrice})
+
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "order.funded", record["msg"])
+		require.Equal(t, "1", record["orderId"])
+		require.Equal(t, "p1", record["producerId"])
+		require.Equal(t, 50.0, record["tokens"])
+		require.Equal(t, "NaN", record["cutOffPrice"])
+	})
+}
@@ -1,72 +1,68 @@
 package domain
 
 import (
+	"fmt"
 	"log/slog"
-	"os"
+	"math"
+	"reflect"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
 )
 
-var logger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-	Level: slog.LevelInfo,
-}))
-
-// Log domain events with structured data
-func logEvent(event string, attrs ...slog.Attr) {
-	// Convert []slog.Attr to []any
-	args := make([]any, len(attrs))
-	for i, attr := range attrs {
-		args[i] = attr
+// LogEvents returns an EventBus subscriber logging every event by its name with the event fields as attributes
+func LogEvents(logger *slog.Logger) func(Event) {
+	return func(e Event) {
+		logger.Info(e.EventName(), eventAttrs(e)...)
 	}
-	logger.Info(event, args...)
-}
-
-// Helper functions to create common attributes
-func withOrderId(id OrderId) slog.Attr {
-	return slog.String("orderId", string(id))
-}
-
-func withProducerId(id ProducerId) slog.Attr {
-	return slog.String("producerId", string(id))
 }
 
-func withConsumerId(id ConsumerId) slog.Attr {
-	return slog.String("consumerId", string(id))
-}
-
-func withTokens(tokens Tokens) slog.Attr {
-	return slog.Int("tokens", int(tokens))
-}
-
-func withCapacity(capacity Capacity) slog.Attr {
-	return slog.Int("capacity", int(capacity))
-}
-
-func withCapacityType(ct CapacityType) slog.Attr {
-	return slog.String("capacityType", string(ct))
-}
-
-func withProduct(product Product) slog.Attr {
-	return slog.Int("product", int(product))
-}
-
-func withCutOffPrice(price CapacityUnitPrice) slog.Attr {
-	return slog.Float64("cutOffPrice", float64(price))
+func eventAttrs(e Event) []any {
+	v := reflect.ValueOf(e)
+	attrs := make([]any, 0, v.NumField())
+	for i := range v.NumField() {
+		attrs = append(attrs, attr(fieldKey(v.Type().Field(i).Name), v.Field(i)))
+	}
+	return attrs
 }
 
-func withCycleCounter(counter uint) slog.Attr {
-	return slog.Uint64("cycleCounter", uint64(counter))
+// fieldKey turns a field name to the attribute key, OrderId is orderId
+func fieldKey(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[size:]
 }
 
-func withState(state SystemState) slog.Attr {
-	return slog.String("state", systemStateToString(state))
+func attr(key string, v reflect.Value) slog.Attr {
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return slog.String(key, s.String())
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return slog.String(key, v.String())
+	case reflect.Bool:
+		return slog.Bool(key, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return slog.Int64(key, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return slog.Uint64(key, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		// JSON has no NaN, an undefined price is logged as a string
+		if f := v.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return slog.String(key, strconv.FormatFloat(f, 'g', -1, 64))
+		}
+		return slog.Float64(key, v.Float())
+	default:
+		return slog.Any(key, v.Interface())
+	}
 }
 
-func systemStateToString(state SystemState) string {
-	switch state {
-	case SystemStateOrdersPlacement:
-		return "OrdersPlacement"
-	case SystemStateOrdering:
-		return "Ordering"
+func (t InvestmentType) String() string {
+	switch t {
+	case InvestmentTypeUpgrade:
+		return "upgrade"
+	case InvestmentTypeRestoration:
+		return "restoration"
 	default:
-		return "Unknown"
+		return "unknown"
 	}
 }
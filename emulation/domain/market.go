@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+// MarketRecord is the outcome of a single producer's auction in a cycle
+type MarketRecord struct {
+	Cycle             uint              `json:"cycle"`
+	ProducerId        ProducerId        `json:"producerId"`
+	CapacityType      CapacityType      `json:"capacityType"`
+	CutOffPrice       CapacityUnitPrice `json:"cutOffPrice"`
+	AvailableCapacity Capacity          `json:"availableCapacity"`
+	RequestedCapacity Capacity          `json:"requestedCapacity"`
+	AcceptedCapacity  Capacity          `json:"acceptedCapacity"`
+	RejectedCapacity  Capacity          `json:"rejectedCapacity"`
+	Funds             Tokens            `json:"funds"`
+}
+
+func newMarketRecord(cycle uint, p *ProducingAgent, available Capacity, result ProductionResult) MarketRecord {
+	rejected := lo.SumBy(result.Rejected, func(b Bid) Capacity {
+		return b.Capacity
+	})
+	accepted := p.producerState.requestedCapacity - rejected
+	// the producer keeps the price of the last accepted bid, but the cycle has none if nothing was accepted
+	cutOffPrice := p.producerState.cutOffPrice
+	if accepted == 0 {
+		cutOffPrice = UndefinedPrice
+	}
+	return MarketRecord{
+		Cycle:             cycle,
+		ProducerId:        p.id,
+		CapacityType:      p.capacityType,
+		CutOffPrice:       cutOffPrice,
+		AvailableCapacity: available,
+		RequestedCapacity: p.producerState.requestedCapacity,
+		AcceptedCapacity:  accepted,
+		RejectedCapacity:  rejected,
+		Funds:             p.producerState.funds,
+	}
+}
+
+// MarketHistory returns market records of the cycles within [fromCycle, toCycle] range.
+// The records are appended in the order of the cycles, the result shares them with the history.
+func (s *System) MarketHistory(fromCycle, toCycle uint) []MarketRecord {
+	from := sort.Search(len(s.marketHistory), func(i int) bool { return s.marketHistory[i].Cycle >= fromCycle })
+	to := sort.Search(len(s.marketHistory), func(i int) bool { return s.marketHistory[i].Cycle > toCycle })
+	if from >= to {
+		return nil
+	}
+	return s.marketHistory[from:to:to]
+}
@@ -2,7 +2,7 @@ package domain
 
 import (
 	"errors"
-	"log/slog"
+	"strconv"
 )
 
 type partStatus byte
@@ -35,17 +35,21 @@ type OrderIdGenerator interface {
 	New() OrderId
 }
 
+// SequentialIdGenerator issues the order ids 0, 1, 2, ...
+type SequentialIdGenerator uint64
+
+func (s *SequentialIdGenerator) New() OrderId {
+	id := OrderId(strconv.FormatUint(uint64(*s), 10))
+	(*s)++
+	return id
+}
+
 func NewInvestmentOrder(id OrderId, ps ProcessSheet, request InvestmentRequest) *Order {
 	parts := make(map[CapacityType]*part, len(ps.Require))
 	for t, capacity := range ps.Require {
 		parts[t] = &part{capacity, unknown}
 	}
-	order := &Order{id, 0, parts, nil, &request, 0, false}
-	logEvent("order.investment.created",
-		withOrderId(id),
-		withProducerId(request.ProducerId),
-		withProduct(request.Product))
-	return order
+	return &Order{id, 0, parts, nil, &request, 0, false}
 }
 
 func NewConsumerOrder(id OrderId, ps ProcessSheet, request ConsumerRequest) *Order {
@@ -53,19 +57,13 @@ func NewConsumerOrder(id OrderId, ps ProcessSheet, request ConsumerRequest) *Ord
 	for t, capacity := range ps.Require {
 		parts[t] = &part{capacity, unknown}
 	}
-	order := &Order{id, request.Tokens, parts, &request, nil, 0, true}
-	logEvent("order.consumer.created",
-		withOrderId(id),
-		withConsumerId(request.ConsumerId),
-		withProduct(request.Product),
-		withTokens(request.Tokens))
-	return order
+	return &Order{id, request.Tokens, parts, &request, nil, 0, true}
 }
 
 type OrderInfo struct {
-	Id       OrderId
-	Tokens   Tokens
-	Required map[CapacityType]Capacity
+	Id       OrderId                   `json:"id"`
+	Tokens   Tokens                    `json:"tokens"`
+	Required map[CapacityType]Capacity `json:"required"`
 }
 
 func (i OrderInfo) Fulfilled() bool {
@@ -120,10 +118,6 @@ func (o *Order) Fund(t Tokens) {
 	}
 	o.tokens = t
 	o.funded = true
-	logEvent("order.funded",
-		withOrderId(o.id),
-		withTokens(t),
-		withProducerId(o.investmentRequest.ProducerId))
 }
 
 func (o *Order) getPartStatus(ct CapacityType) partStatus {
@@ -139,10 +133,6 @@ func (o *Order) spendTokens(t Tokens) {
 		panic("too few tokens left")
 	}
 	o.tokens -= t
-	logEvent("order.tokens.spent",
-		withOrderId(o.id),
-		withTokens(t),
-		slog.Int("remainingTokens", int(o.tokens)))
 }
 
 func (o *Order) Processing(ct CapacityType, t Tokens) {
@@ -156,10 +146,6 @@ func (o *Order) Processing(ct CapacityType, t Tokens) {
 	}
 	o.spendTokens(t)
 	o.parts[ct].status = processing
-	logEvent("order.part.processing",
-		withOrderId(o.id),
-		withCapacityType(ct),
-		withTokens(t))
 }
 
 func (o *Order) Completed(ct CapacityType, t Tokens) {
@@ -172,10 +158,6 @@ func (o *Order) Completed(ct CapacityType, t Tokens) {
 		o.spendTokens(t)
 	}
 	o.parts[ct].status = completed
-	logEvent("order.part.completed",
-		withOrderId(o.id),
-		withCapacityType(ct),
-		withTokens(t))
 }
 
 func (o *Order) Rejected(ct CapacityType) {
@@ -185,9 +167,6 @@ func (o *Order) Rejected(ct CapacityType) {
 		panic(ErrWrongState)
 	}
 	o.parts[ct].status = rejected
-	logEvent("order.part.rejected",
-		withOrderId(o.id),
-		withCapacityType(ct))
 }
 
 type OrderEvent any
@@ -211,75 +190,37 @@ func (o *Order) CompleteCycle() (Score, OrderEvent) {
 	o.mustBeFunded()
 	rejectedCount := 0
 	completedCount := 0
-	for ct, part := range o.parts {
+	for _, part := range o.parts {
 		switch part.status {
 		case rejected:
 			rejectedCount++
-			logEvent("order.cycle.part.rejected",
-				withOrderId(o.id),
-				withCapacityType(ct))
 		case completed:
 			completedCount++
-			logEvent("order.cycle.part.completed",
-				withOrderId(o.id),
-				withCapacityType(ct))
 		}
 	}
 
-	logEvent("order.cycle.status",
-		withOrderId(o.id),
-		slog.Int("completedParts", completedCount),
-		slog.Int("rejectedParts", rejectedCount),
-		slog.Int("totalParts", len(o.parts)),
-		slog.Uint64("cycleCounter", uint64(o.cycleCounter)))
-
 	// completed
 	if completedCount == len(o.parts) {
 		scores := Score(0)
 		if o.consumerRequest != nil {
-			logEvent("order.cycle.completed.consumer",
-				withOrderId(o.id),
-				withConsumerId(o.consumerRequest.ConsumerId),
-				withProduct(o.consumerRequest.Product))
 			return scores, ConsumerRequestCompleted{o.consumerRequest}
 		}
-		logEvent("order.cycle.completed.investment",
-			withOrderId(o.id),
-			withProducerId(o.investmentRequest.ProducerId),
-			withProduct(o.investmentRequest.Product))
 		return scores, InvestmentRequestCompleted{o.investmentRequest}
 	}
 	if rejectedCount == len(o.parts) {
 		scores := Score(3)
 		if o.consumerRequest != nil {
-			logEvent("order.cycle.rejected.consumer",
-				withOrderId(o.id),
-				withConsumerId(o.consumerRequest.ConsumerId),
-				withTokens(o.tokens))
 			return scores, ConsumerRequestRejected{o.tokens, o.consumerRequest}
 		}
-		logEvent("order.cycle.rejected.investment",
-			withOrderId(o.id),
-			withProducerId(o.investmentRequest.ProducerId))
 		return scores, InvestmentRequestRejected{o.investmentRequest}
 	}
 	if o.cycleCounter == 2 {
 		scores := Score(5)
 		if o.consumerRequest != nil {
-			logEvent("order.cycle.timeout.consumer",
-				withOrderId(o.id),
-				withConsumerId(o.consumerRequest.ConsumerId),
-				withTokens(o.tokens))
 			return scores, ConsumerRequestRejected{o.tokens, o.consumerRequest}
 		}
-		logEvent("order.cycle.timeout.investment",
-			withOrderId(o.id),
-			withProducerId(o.investmentRequest.ProducerId))
 		return scores, InvestmentRequestRejected{o.investmentRequest}
 	}
 	o.cycleCounter++
-	logEvent("order.cycle.processing",
-		withOrderId(o.id),
-		slog.Uint64("cycleCounter", uint64(o.cycleCounter)))
 	return Score(1), OrderStillProcessing{}
 }
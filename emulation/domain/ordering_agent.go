@@ -2,7 +2,6 @@ package domain
 
 import (
 	"fmt"
-	"log/slog"
 
 	"github.com/samber/lo"
 )
@@ -21,11 +20,12 @@ type Request struct {
 
 type OrderingAgentView struct {
 	Incoming  map[OrderId]map[CapacityType]Capacity
+	Tokens    map[OrderId]Tokens
 	Producers map[CapacityType]map[ProducerId]ProducerInfo
 }
 
 type OrderingAgentCommand struct {
-	Orders map[OrderId]map[ProducerId]Tokens
+	Orders map[OrderId]map[ProducerId]Tokens `json:"orders,omitempty"`
 }
 
 type OrderingAgent struct {
@@ -39,9 +39,6 @@ func (oa *OrderingAgent) PlaceOrder(orderInfo OrderInfo) {
 		return
 	}
 	oa.incoming[orderInfo.Id] = orderInfo
-	logEvent("ordering.order.placed",
-		withOrderId(orderInfo.Id),
-		withTokens(orderInfo.Tokens))
 }
 
 func (oa *OrderingAgent) View(producers map[ProducerId]ProducerInfo) OrderingAgentView {
@@ -53,6 +50,9 @@ func (oa *OrderingAgent) View(producers map[ProducerId]ProducerInfo) OrderingAge
 			}
 			return id, oi.Required
 		}),
+		Tokens: lo.MapValues(oa.incoming, func(oi OrderInfo, _ OrderId) Tokens {
+			return oi.Tokens
+		}),
 	}
 	result.Producers = make(map[CapacityType]map[ProducerId]ProducerInfo, len(capacityTypes))
 	for produerId, p := range producers {
@@ -71,10 +71,7 @@ func (oa *OrderingAgent) View(producers map[ProducerId]ProducerInfo) OrderingAge
 }
 
 func (oa *OrderingAgent) CompleteCycle() {
-	logEvent("ordering.cycle.completed",
-		slog.String("agentId", string(oa.id)),
-		slog.Int("orders", len(oa.incoming)))
-	oa.incoming, oa.cmdHandled = map[OrderId]OrderInfo{}, true
+	oa.incoming, oa.cmdHandled = map[OrderId]OrderInfo{}, false
 }
 
 func (oa *OrderingAgent) HandleCmd(cmd OrderingAgentCommand, producers map[ProducerId]ProducerInfo) (map[ProducerId][]Bid, error) {
@@ -85,10 +82,6 @@ func (oa *OrderingAgent) HandleCmd(cmd OrderingAgentCommand, producers map[Produ
 		return nil, fmt.Errorf("too few orders passed. Incoming [%d] passed [%d]", len(oa.incoming), len(cmd.Orders))
 	}
 
-	logEvent("ordering.command.received",
-		slog.String("agentId", string(oa.id)),
-		slog.Int("orders", len(cmd.Orders)))
-
 	result := map[ProducerId][]Bid{}
 	for orderId, bids := range cmd.Orders {
 		order, ok := oa.incoming[orderId]
@@ -109,19 +102,21 @@ func (oa *OrderingAgent) HandleCmd(cmd OrderingAgentCommand, producers map[Produ
 				return nil, fmt.Errorf("order [%s] doesn't contain capacity type for producer [%s] with capacity type [%s]", orderId, producerId, capType)
 			}
 			result[producerId] = append(result[producerId], Bid{capType, required, tokens, orderId})
-			logEvent("ordering.bid.created",
-				slog.String("agentId", string(oa.id)),
-				withOrderId(orderId),
-				withProducerId(producerId),
-				withCapacityType(capType),
-				withCapacity(required),
-				withTokens(tokens))
 		}
 	}
 	oa.cmdHandled = true
 	return result, nil
 }
 
+// Pass ends the agent's turn without bids, its orders are left unplaced in this cycle
+func (oa *OrderingAgent) Pass() error {
+	if oa.cmdHandled {
+		return fmt.Errorf("command is handled already")
+	}
+	oa.cmdHandled = true
+	return nil
+}
+
 func NewOrderingAgent(id OrderingAgentId) *OrderingAgent {
 	return &OrderingAgent{id, make(map[OrderId]OrderInfo), false}
 }
@@ -1 +1,43 @@
 package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderingAgentCompleteCycle(t *testing.T) {
+	producers := map[ProducerId]ProducerInfo{"p1": {Id: "p1", CapacityType: "1", Capacity: 100, MaxCapacity: 100}}
+	order := func(id OrderId) OrderInfo {
+		return OrderInfo{id, 10, map[CapacityType]Capacity{"1": 10}}
+	}
+
+	t.Run(`Given an ordering agent that handled a command in the first cycle
+		When the cycle is completed and a new order is placed
+		Then the agent can command again in the second cycle, once`, func(t *testing.T) {
+
+		agent := NewOrderingAgent("c1")
+		agent.PlaceOrder(order("0"))
+		_, err := agent.HandleCmd(OrderingAgentCommand{Orders: map[OrderId]map[ProducerId]Tokens{"0": {"p1": 10}}}, producers)
+		require.NoError(t, err)
+		_, err = agent.HandleCmd(OrderingAgentCommand{Orders: map[OrderId]map[ProducerId]Tokens{"0": {"p1": 10}}}, producers)
+		require.ErrorContains(t, err, "handled already")
+
+		agent.CompleteCycle()
+		agent.PlaceOrder(order("1"))
+		bids, err := agent.HandleCmd(OrderingAgentCommand{Orders: map[OrderId]map[ProducerId]Tokens{"1": {"p1": 10}}}, producers)
+		require.NoError(t, err)
+		require.Equal(t, map[ProducerId][]Bid{"p1": {{"1", 10, 10, "1"}}}, bids)
+		require.ErrorContains(t, agent.Pass(), "handled already")
+	})
+
+	t.Run(`Given an ordering agent that passed in the first cycle
+		When the cycle is completed
+		Then the agent can pass again`, func(t *testing.T) {
+
+		agent := NewOrderingAgent("c1")
+		require.NoError(t, agent.Pass())
+		agent.CompleteCycle()
+		require.NoError(t, agent.Pass())
+	})
+}
@@ -1,9 +1,10 @@
 package domain
 
 import (
+	"cmp"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"log/slog"
 	"math"
 	"slices"
 
@@ -14,14 +15,16 @@ type ProducerId string
 
 type DegradationRate uint
 
+// Restoration restores the capacity of the producer for an order of the required product
 type Restoration struct {
-	Require  Product
-	Restores Capacity
+	Require  Product  `json:"require"`
+	Restores Capacity `json:"restores"`
 }
 
+// Upgrade increases the maximal capacity of the producer for an order of the required product
 type Upgrade struct {
-	Require   Product
-	Increases Capacity
+	Require   Product  `json:"require"`
+	Increases Capacity `json:"increases"`
 }
 
 type booking struct {
@@ -39,19 +42,19 @@ type ProducerInfo struct {
 }
 
 type ProducingAgentConfig struct {
-	Id          ProducerId
-	Type        CapacityType
-	Capacity    Capacity
-	Degradation DegradationRate
-	Restoration Restoration
-	Upgrade     Upgrade
+	Id          ProducerId      `json:"id"`
+	Type        CapacityType    `json:"type"`
+	Capacity    Capacity        `json:"capacity"`
+	Degradation DegradationRate `json:"degradation"`
+	Restoration Restoration     `json:"restoration,omitzero"`
+	Upgrade     Upgrade         `json:"upgrade,omitzero"`
 }
 
 type Bid struct {
-	CapacityType CapacityType
-	Capacity     Capacity
-	Tokens       Tokens
-	OrderId      OrderId
+	CapacityType CapacityType `json:"capacityType"`
+	Capacity     Capacity     `json:"capacity"`
+	Tokens       Tokens       `json:"tokens"`
+	OrderId      OrderId      `json:"orderId"`
 }
 
 type CapacityUnitPrice float64
@@ -69,6 +72,22 @@ func (f CapacityUnitPrice) Equal(other CapacityUnitPrice) bool {
 	return float64(f) == float64(other)
 }
 
+// MarshalJSON writes the undefined price as null, JSON has no NaN
+func (f CapacityUnitPrice) MarshalJSON() ([]byte, error) {
+	if f.IsNaN() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(float64(f))
+}
+
+func (f *CapacityUnitPrice) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = UndefinedPrice
+		return nil
+	}
+	return json.Unmarshal(data, (*float64)(f))
+}
+
 func (b Bid) CapacityUnitPrice() CapacityUnitPrice {
 	return CapacityUnitPrice(float32(b.Tokens) / float32(b.Capacity))
 }
@@ -126,12 +145,6 @@ func (p *ProducingAgent) PlaceBids(bids []Bid) {
 		if bids[i].CapacityType != p.capacityType {
 			panic("wrong capacity type")
 		}
-		logEvent("producer.bid.placed",
-			withProducerId(p.id),
-			withOrderId(bids[i].OrderId),
-			withCapacityType(bids[i].CapacityType),
-			withCapacity(bids[i].Capacity),
-			withTokens(bids[i].Tokens))
 	}
 	p.producerState.bids = append(p.producerState.bids, bids...)
 }
@@ -143,8 +156,8 @@ type ProductionResult struct {
 }
 
 type ProducingAgentCommand struct {
-	DoRestoration bool
-	DoUpgrade     bool
+	DoRestoration bool `json:"doRestoration,omitempty"`
+	DoUpgrade     bool `json:"doUpgrade,omitempty"`
 }
 
 type InvestmentType byte
@@ -155,10 +168,10 @@ const (
 )
 
 type InvestmentRequest struct {
-	ProducerId  ProducerId
-	Type        InvestmentType
-	Product     Product
-	CutOffPrice CapacityUnitPrice
+	ProducerId  ProducerId        `json:"producerId"`
+	Type        InvestmentType    `json:"type"`
+	Product     Product           `json:"product"`
+	CutOffPrice CapacityUnitPrice `json:"cutOffPrice"`
 }
 
 func (p *ProducingAgent) HandleCmd(cmd ProducingAgentCommand) ([]InvestmentRequest, error) {
@@ -173,18 +186,10 @@ func (p *ProducingAgent) HandleCmd(cmd ProducingAgentCommand) ([]InvestmentReque
 	}
 	requests := []InvestmentRequest{}
 	if cmd.DoUpgrade {
-		logEvent("producer.upgrade.requested",
-			withProducerId(p.id),
-			withProduct(p.upgrade.Require),
-			withCapacity(p.upgrade.Increases))
 		requests = append(requests, InvestmentRequest{p.id, InvestmentTypeUpgrade, p.upgrade.Require, p.producerState.cutOffPrice})
 		p.consumerState.upgradeRunning = true
 	}
 	if cmd.DoRestoration {
-		logEvent("producer.restoration.requested",
-			withProducerId(p.id),
-			withProduct(p.restoration.Require),
-			withCapacity(p.restoration.Restores))
 		requests = append(requests, InvestmentRequest{p.id, InvestmentTypeRestoration, p.restoration.Require, p.producerState.cutOffPrice})
 		p.consumerState.restorationRunning = true
 	}
@@ -205,27 +210,14 @@ func (p *ProducingAgent) InvesetmentCompleted(request *InvestmentRequest) {
 			panic(ErrNoRestorationRunning)
 		}
 		p.consumerState.restorationRunning = false
-		oldCapacity := p.producerState.capacity
 		p.producerState.capacity = min(p.producerState.maxCapacity, p.producerState.capacity+p.restoration.Restores)
-		logEvent("producer.restoration.completed",
-			withProducerId(p.id),
-			withCapacity(oldCapacity),
-			withCapacity(p.producerState.capacity))
 	case InvestmentTypeUpgrade:
 		if !p.consumerState.upgradeRunning {
 			panic(ErrNoUpgradesRunning)
 		}
 		p.consumerState.upgradeRunning = false
-		oldMaxCapacity := p.producerState.maxCapacity
-		oldCapacity := p.producerState.capacity
 		p.producerState.maxCapacity += p.upgrade.Increases
 		p.producerState.capacity += p.upgrade.Increases
-		logEvent("producer.upgrade.completed",
-			withProducerId(p.id),
-			withCapacity(oldMaxCapacity),
-			withCapacity(p.producerState.maxCapacity),
-			withCapacity(oldCapacity),
-			withCapacity(p.producerState.capacity))
 	default:
 		panic(errors.ErrUnsupported)
 	}
@@ -241,27 +233,21 @@ func (p *ProducingAgent) InvesetmentRejected(request *InvestmentRequest) {
 			panic(ErrNoRestorationRunning)
 		}
 		p.consumerState.restorationRunning = false
-		logEvent("producer.restoration.rejected",
-			withProducerId(p.id))
 	case InvestmentTypeUpgrade:
 		if !p.consumerState.upgradeRunning {
 			panic(ErrNoUpgradesRunning)
 		}
 		p.consumerState.upgradeRunning = false
-		logEvent("producer.upgrade.rejected",
-			withProducerId(p.id))
 	default:
 		panic(errors.ErrUnsupported)
 	}
 }
 
 func (p *ProducingAgent) Produce() ProductionResult {
-	// sorting bids in descending order by the capacity unit price (most valuable come first)
+	// sorting bids in descending order by the capacity unit price (most valuable come first),
+	// equally valuable bids are taken by order id so that the auction doesn't depend on the bids arrival
 	slices.SortFunc(p.producerState.bids, func(a, b Bid) int {
-		if a.CapacityUnitPrice() < b.CapacityUnitPrice() {
-			return 1
-		}
-		return -1
+		return cmp.Or(cmp.Compare(b.CapacityUnitPrice(), a.CapacityUnitPrice()), cmp.Compare(a.OrderId, b.OrderId))
 	})
 	requestedCapacity := lo.SumBy(p.producerState.bids, func(b Bid) Capacity {
 		return b.Capacity
@@ -272,12 +258,6 @@ func (p *ProducingAgent) Produce() ProductionResult {
 	processing := []Bid{}
 	capacity := max(0, p.producerState.capacity-p.capacityDegradation())
 
-	logEvent("producer.production.started",
-		withProducerId(p.id),
-		withCapacity(p.producerState.capacity),
-		withCapacity(capacity),
-		withCapacity(requestedCapacity))
-
 	cutOffPrice := p.producerState.cutOffPrice
 	funds := Tokens(0)
 	inProgress := p.producerState.inProgress
@@ -310,15 +290,6 @@ func (p *ProducingAgent) Produce() ProductionResult {
 		processing = append(processing, inProgress.bid)
 	}
 
-	logEvent("producer.production.completed",
-		withProducerId(p.id),
-		withCapacity(capacity),
-		withTokens(funds),
-		withCutOffPrice(cutOffPrice),
-		slog.Int("completed", len(completed)),
-		slog.Int("rejected", len(rejected)),
-		slog.Int("processing", len(processing)))
-
 	p.cmdHandled = false
 	return ProductionResult{processing, completed, rejected}
 }
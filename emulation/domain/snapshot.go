@@ -0,0 +1,257 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/samber/lo"
+)
+
+var ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+// Snapshot is the serialisable state of the System. The process sheets, the producers' degradation, upgrade and
+// restoration and the cycle emission come from the configuration the snapshot is restored with.
+type Snapshot struct {
+	State          SystemState             `json:"state"`
+	CycleCounter   uint                    `json:"cycleCounter"`
+	InvestmentFund Tokens                  `json:"investmentFund"`
+	Producers      []ProducerSnapshot      `json:"producers"`
+	OrderingAgents []OrderingAgentSnapshot `json:"orderingAgents"`
+	Orders         []OrderSnapshot         `json:"orders"`
+	Consumers      []ConsumerSnapshot      `json:"consumers"`
+	MarketHistory  []MarketRecord          `json:"marketHistory,omitempty"`
+}
+
+type BookingSnapshot struct {
+	OrderId OrderId  `json:"orderId"`
+	Booked  Capacity `json:"booked"`
+	Bid     Bid      `json:"bid"`
+}
+
+type ProducerSnapshot struct {
+	Id                 ProducerId        `json:"id"`
+	Capacity           Capacity          `json:"capacity"`
+	MaxCapacity        Capacity          `json:"maxCapacity"`
+	Bids               []Bid             `json:"bids,omitempty"`
+	InProgress         *BookingSnapshot  `json:"inProgress,omitempty"`
+	RequestedCapacity  Capacity          `json:"requestedCapacity"`
+	Funds              Tokens            `json:"funds"`
+	CutOffPrice        CapacityUnitPrice `json:"cutOffPrice"`
+	UpgradeRunning     bool              `json:"upgradeRunning,omitempty"`
+	RestorationRunning bool              `json:"restorationRunning,omitempty"`
+	CmdHandled         bool              `json:"cmdHandled,omitempty"`
+}
+
+type OrderingAgentSnapshot struct {
+	Id         OrderingAgentId `json:"id"`
+	Incoming   []OrderInfo     `json:"incoming,omitempty"`
+	CmdHandled bool            `json:"cmdHandled,omitempty"`
+}
+
+type PartSnapshot struct {
+	CapacityType CapacityType `json:"capacityType"`
+	Capacity     Capacity     `json:"capacity"`
+	// Status is unknown, processing, rejected or completed
+	Status string `json:"status"`
+}
+
+// OrderSnapshot has either the consumer or the investment request
+type OrderSnapshot struct {
+	Id                OrderId            `json:"id"`
+	Tokens            Tokens             `json:"tokens"`
+	Parts             []PartSnapshot     `json:"parts"`
+	ConsumerRequest   *ConsumerRequest   `json:"consumerRequest,omitempty"`
+	InvestmentRequest *InvestmentRequest `json:"investmentRequest,omitempty"`
+	CycleCounter      uint               `json:"cycleCounter"`
+	Funded            bool               `json:"funded"`
+}
+
+// ConsumerSnapshot is the state of a consumer, the random source is kept in its binary form
+type ConsumerSnapshot struct {
+	Id     ConsumerId `json:"id"`
+	Tokens Tokens     `json:"tokens"`
+	Random []byte     `json:"random,omitempty"`
+}
+
+// SnapshotConsumer is a consumer which state can be saved and restored
+type SnapshotConsumer interface {
+	Consumer
+	Snapshot() (ConsumerSnapshot, error)
+	Restore(ConsumerSnapshot) error
+}
+
+var partStatuses = map[partStatus]string{
+	unknown:    "unknown",
+	processing: "processing",
+	rejected:   "rejected",
+	completed:  "completed",
+}
+
+// Snapshot implements SnapshotConsumer.
+func (c *RandomConsumer) Snapshot() (ConsumerSnapshot, error) {
+	random, err := c.src.MarshalBinary()
+	if err != nil {
+		return ConsumerSnapshot{}, err
+	}
+	return ConsumerSnapshot{c.id, c.tokens, random}, nil
+}
+
+// Restore implements SnapshotConsumer.
+func (c *RandomConsumer) Restore(s ConsumerSnapshot) error {
+	src := &rand.PCG{}
+	if err := src.UnmarshalBinary(s.Random); err != nil {
+		return err
+	}
+	c.tokens, c.src, c.rnd = s.Tokens, src, rand.New(src)
+	return nil
+}
+
+// Snapshot saves the state of the system, all consumers must implement SnapshotConsumer
+func (s *System) Snapshot() (Snapshot, error) {
+	result := Snapshot{
+		State:          s.state,
+		CycleCounter:   s.cycleCounter,
+		InvestmentFund: s.investmentFund,
+		MarketHistory:  slices.Clone(s.marketHistory),
+	}
+	for _, id := range slices.Sorted(maps.Keys(s.producingAgents)) {
+		p := s.producingAgents[id]
+		var inProgress *BookingSnapshot
+		if b := p.producerState.inProgress; b != nil {
+			inProgress = &BookingSnapshot{b.orderId, b.booked, b.bid}
+		}
+		result.Producers = append(result.Producers, ProducerSnapshot{
+			id, p.producerState.capacity, p.producerState.maxCapacity, slices.Clone(p.producerState.bids), inProgress,
+			p.producerState.requestedCapacity, p.producerState.funds, p.producerState.cutOffPrice,
+			bool(p.consumerState.upgradeRunning), bool(p.consumerState.restorationRunning), p.cmdHandled,
+		})
+	}
+	for _, id := range slices.Sorted(maps.Keys(s.orderingAgents)) {
+		oa := s.orderingAgents[id]
+		incoming := lo.Map(slices.Sorted(maps.Keys(oa.incoming)), func(orderId OrderId, _ int) OrderInfo {
+			return oa.incoming[orderId]
+		})
+		result.OrderingAgents = append(result.OrderingAgents, OrderingAgentSnapshot{id, incoming, oa.cmdHandled})
+	}
+	for _, id := range slices.Sorted(maps.Keys(s.orders)) {
+		o := s.orders[id]
+		parts := lo.Map(slices.Sorted(maps.Keys(o.parts)), func(ct CapacityType, _ int) PartSnapshot {
+			return PartSnapshot{ct, o.parts[ct].capacity, partStatuses[o.parts[ct].status]}
+		})
+		result.Orders = append(result.Orders, OrderSnapshot{id, o.tokens, parts, o.consumerRequest, o.investmentRequest, o.cycleCounter, o.funded})
+	}
+	for _, id := range slices.Sorted(maps.Keys(s.consumers)) {
+		c, ok := s.consumers[id].(SnapshotConsumer)
+		if !ok {
+			return Snapshot{}, fmt.Errorf("consumer [%s] doesn't support snapshots", id)
+		}
+		cs, err := c.Snapshot()
+		if err != nil {
+			return Snapshot{}, fmt.Errorf("consumer [%s]: %w", id, err)
+		}
+		result.Consumers = append(result.Consumers, cs)
+	}
+	return result, nil
+}
+
+// RestoreSystem creates the system of the configuration in the state of the snapshot. The snapshot must have
+// the agents and the consumers of the configuration, the consumers must implement SnapshotConsumer.
+func RestoreSystem(idGen OrderIdGenerator, config *Configuration, consumers map[ConsumerId]Consumer, events *EventBus, snapshot Snapshot) (*System, error) {
+	s := newSystem(idGen, config, consumers, events)
+	s.state = snapshot.State
+	s.cycleCounter = snapshot.CycleCounter
+	s.investmentFund = snapshot.InvestmentFund
+	s.marketHistory = slices.Clone(snapshot.MarketHistory)
+	if s.state != SystemStateOrdersPlacement && s.state != SystemStateOrdering {
+		return nil, fmt.Errorf("%w: unknown state [%d]", ErrInvalidSnapshot, s.state)
+	}
+
+	if err := sameIds(lo.Keys(s.producingAgents), lo.Map(snapshot.Producers, func(p ProducerSnapshot, _ int) ProducerId { return p.Id })); err != nil {
+		return nil, fmt.Errorf("%w: producers %w", ErrInvalidSnapshot, err)
+	}
+	for _, ps := range snapshot.Producers {
+		p := s.producingAgents[ps.Id]
+		var inProgress *booking
+		if b := ps.InProgress; b != nil {
+			inProgress = &booking{b.OrderId, b.Booked, b.Bid}
+		}
+		for _, b := range ps.Bids {
+			if b.CapacityType != p.capacityType {
+				return nil, fmt.Errorf("%w: producer [%s] has a bid of capacity type [%s]", ErrInvalidSnapshot, ps.Id, b.CapacityType)
+			}
+		}
+		p.producerState = producerState{ps.Capacity, ps.MaxCapacity, slices.Clone(ps.Bids), inProgress, ps.RequestedCapacity, ps.Funds, ps.CutOffPrice}
+		p.consumerState = consumerState{UpgradeRunning(ps.UpgradeRunning), RestorationRunning(ps.RestorationRunning)}
+		p.cmdHandled = ps.CmdHandled
+	}
+
+	if err := sameIds(lo.Keys(s.orderingAgents), lo.Map(snapshot.OrderingAgents, func(a OrderingAgentSnapshot, _ int) OrderingAgentId { return a.Id })); err != nil {
+		return nil, fmt.Errorf("%w: ordering agents %w", ErrInvalidSnapshot, err)
+	}
+	for _, as := range snapshot.OrderingAgents {
+		oa := s.orderingAgents[as.Id]
+		oa.incoming = lo.SliceToMap(as.Incoming, func(oi OrderInfo) (OrderId, OrderInfo) { return oi.Id, oi })
+		oa.cmdHandled = as.CmdHandled
+	}
+
+	for _, ord := range snapshot.Orders {
+		order, err := restoreOrder(ord)
+		if err != nil {
+			return nil, fmt.Errorf("%w: order [%s]: %w", ErrInvalidSnapshot, ord.Id, err)
+		}
+		if r := ord.ConsumerRequest; r != nil && s.consumers[r.ConsumerId] == nil {
+			return nil, fmt.Errorf("%w: order [%s] of unknown consumer [%s]", ErrInvalidSnapshot, ord.Id, r.ConsumerId)
+		}
+		if r := ord.InvestmentRequest; r != nil && s.producingAgents[r.ProducerId] == nil {
+			return nil, fmt.Errorf("%w: order [%s] of unknown producer [%s]", ErrInvalidSnapshot, ord.Id, r.ProducerId)
+		}
+		s.orders[ord.Id] = order
+	}
+
+	if err := sameIds(lo.Keys(s.consumers), lo.Map(snapshot.Consumers, func(c ConsumerSnapshot, _ int) ConsumerId { return c.Id })); err != nil {
+		return nil, fmt.Errorf("%w: consumers %w", ErrInvalidSnapshot, err)
+	}
+	for _, cs := range snapshot.Consumers {
+		c, ok := s.consumers[cs.Id].(SnapshotConsumer)
+		if !ok {
+			return nil, fmt.Errorf("consumer [%s] doesn't support snapshots", cs.Id)
+		}
+		if err := c.Restore(cs); err != nil {
+			return nil, fmt.Errorf("%w: consumer [%s]: %w", ErrInvalidSnapshot, cs.Id, err)
+		}
+	}
+
+	s.refreshProducerInfos()
+	return s, nil
+}
+
+func restoreOrder(ord OrderSnapshot) (*Order, error) {
+	if (ord.ConsumerRequest == nil) == (ord.InvestmentRequest == nil) {
+		return nil, errors.New("exactly one of the consumer and the investment requests is required")
+	}
+	if len(ord.Parts) == 0 {
+		return nil, errors.New("no parts")
+	}
+	parts := make(map[CapacityType]*part, len(ord.Parts))
+	for _, p := range ord.Parts {
+		status, ok := lo.FindKey(partStatuses, p.Status)
+		if !ok {
+			return nil, fmt.Errorf("unknown part status [%s]", p.Status)
+		}
+		parts[p.CapacityType] = &part{p.Capacity, status}
+	}
+	return &Order{ord.Id, ord.Tokens, parts, ord.ConsumerRequest, ord.InvestmentRequest, ord.CycleCounter, ord.Funded}, nil
+}
+
+// sameIds checks that the snapshot has exactly the ids of the configuration
+func sameIds[T ~string](configured, restored []T) error {
+	slices.Sort(configured)
+	slices.Sort(restored)
+	if !slices.Equal(configured, restored) {
+		return fmt.Errorf("%v don't match the configured ones %v", restored, configured)
+	}
+	return nil
+}
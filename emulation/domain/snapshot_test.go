@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	cfg := setupTestConfig()
+	cfg.config.Consumers = []ConsumerConfig{{"c1", []Product{cfg.consumerProduct}}}
+
+	// play bids all the consumer tokens on p1
+	play := func(t *testing.T, s *System) CycleResult {
+		if s.GetSystemInfo().State == SystemStateOrdersPlacement {
+			require.NoError(t, s.StartOrdering())
+		}
+		view, err := s.OrderingAgentView("c1")
+		require.NoError(t, err)
+		orders := map[OrderId]map[ProducerId]Tokens{}
+		for id, tokens := range view.Tokens {
+			orders[id] = map[ProducerId]Tokens{"p1": tokens}
+		}
+		require.NoError(t, s.OrderingAgentAction("c1", OrderingAgentCommand{Orders: orders}))
+		result, err := s.CompleteCycle()
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run(`Given a system in the middle of the ordering phase with a running investment
+		When it is saved as JSON and restored
+		Then the restored system has the same state
+		And plays the next cycles exactly as the original one`, func(t *testing.T) {
+
+		idGen := SequentialIdGenerator(0)
+		system := NewSystem(&idGen, cfg.config, NewConsumers(cfg.config.Consumers, 42), nil)
+		play(t, system)
+		require.NoError(t, system.ProducingAgentAction("p1", ProducingAgentCommand{DoUpgrade: true}))
+		require.NoError(t, system.StartOrdering())
+		require.NoError(t, system.OrderingAgentPass("p1"))
+
+		snapshot, err := system.Snapshot()
+		require.NoError(t, err)
+		require.Equal(t, SystemStateOrdering, snapshot.State)
+		require.Equal(t, uint(2), snapshot.CycleCounter)
+		require.True(t, snapshot.Producers[0].UpgradeRunning)
+		require.Len(t, snapshot.Orders, 2)
+		data, err := json.Marshal(snapshot)
+		require.NoError(t, err)
+		var decoded Snapshot
+		require.NoError(t, json.Unmarshal(data, &decoded))
+
+		restoredIdGen := idGen
+		restored, err := RestoreSystem(&restoredIdGen, cfg.config, NewConsumers(cfg.config.Consumers, 0), nil, decoded)
+		require.NoError(t, err)
+		restoredSnapshot, err := restored.Snapshot()
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(snapshot, restoredSnapshot))
+
+		for range 3 {
+			require.Empty(t, cmp.Diff(play(t, system), play(t, restored)))
+		}
+		require.Empty(t, cmp.Diff(system.MarketHistory(0, 10), restored.MarketHistory(0, 10)))
+	})
+
+	t.Run(`Given a snapshot of another configuration
+		When it is restored
+		Then the snapshot is rejected`, func(t *testing.T) {
+
+		idGen := SequentialIdGenerator(0)
+		snapshot, err := NewSystem(&idGen, cfg.config, NewConsumers(cfg.config.Consumers, 1), nil).Snapshot()
+		require.NoError(t, err)
+		snapshot.Producers = snapshot.Producers[1:]
+
+		_, err = RestoreSystem(&idGen, cfg.config, NewConsumers(cfg.config.Consumers, 1), nil, snapshot)
+		require.ErrorIs(t, err, ErrInvalidSnapshot)
+	})
+}
@@ -2,7 +2,9 @@ package domain
 
 import (
 	"errors"
-	"log/slog"
+	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/samber/lo"
 )
@@ -48,9 +50,19 @@ type System struct {
 	orders          map[OrderId]*Order
 	consumers       map[ConsumerId]Consumer
 	cycleCounter    uint
+	marketHistory   []MarketRecord
+	events          *EventBus
 }
 
-func NewSystem(idGen OrderIdGenerator, config *Configuration, consumers map[ConsumerId]Consumer) *System {
+// NewSystem starts the first cycle, the state changes are published on the events bus, which may be nil
+func NewSystem(idGen OrderIdGenerator, config *Configuration, consumers map[ConsumerId]Consumer, events *EventBus) *System {
+	s := newSystem(idGen, config, consumers, events)
+	s.startCycle()
+	return s
+}
+
+// newSystem creates the agents of the configuration before the first cycle
+func newSystem(idGen OrderIdGenerator, config *Configuration, consumers map[ConsumerId]Consumer, events *EventBus) *System {
 	s := &System{
 		SystemStateOrdersPlacement,
 		idGen,
@@ -70,10 +82,10 @@ func NewSystem(idGen OrderIdGenerator, config *Configuration, consumers map[Cons
 		map[OrderId]*Order{},
 		consumers,
 		0,
+		nil,
+		events,
 	}
-	s.producerInfos = lo.MapEntries(s.producingAgents, func(id ProducerId, ps *ProducingAgent) (ProducerId, ProducerInfo) {
-		return id, ps.Info()
-	})
+	s.refreshProducerInfos()
 	for _, c := range consumers {
 		id := FromConsumerId(c.Id())
 		s.orderingAgents[id] = NewOrderingAgent(id)
@@ -82,7 +94,6 @@ func NewSystem(idGen OrderIdGenerator, config *Configuration, consumers map[Cons
 		id := FromProducerId(prodId)
 		s.orderingAgents[id] = NewOrderingAgent(id)
 	}
-	s.startCycle()
 	return s
 }
 
@@ -109,13 +120,33 @@ func (s *System) OrderingAgentAction(id OrderingAgentId, cmd OrderingAgentComman
 	if err != nil {
 		return err
 	}
-	for prodId, bids := range bids {
+	for _, prodId := range slices.Sorted(maps.Keys(bids)) {
 		p, ok := s.producingAgents[prodId]
 		if !ok {
 			return ErrNotFound
 		}
-		p.PlaceBids(bids)
+		p.PlaceBids(bids[prodId])
+		for _, b := range bids[prodId] {
+			s.events.Publish(BidPlaced{id, b.OrderId, prodId, b.CapacityType, b.Capacity, b.Tokens})
+		}
+	}
+	return nil
+}
+
+// OrderingAgentPass ends the ordering agent's turn without bids
+func (s *System) OrderingAgentPass(id OrderingAgentId) error {
+	if s.state != SystemStateOrdering {
+		return ErrWrongState
+	}
+	agent, ok := s.orderingAgents[id]
+	if !ok {
+		return ErrNotFound
+	}
+	orders := len(agent.incoming)
+	if err := agent.Pass(); err != nil {
+		return err
 	}
+	s.events.Publish(AgentPassed{id, orders})
 	return nil
 }
 
@@ -138,73 +169,73 @@ func (s *System) ProducingAgentAction(id ProducerId, cmd ProducingAgentCommand)
 	if !ok {
 		return ErrNotFound
 	}
+	if _, ok := s.processSheets[p.upgrade.Require]; cmd.DoUpgrade && !ok {
+		return fmt.Errorf("%w: no process sheet for upgrade product [%d]", ErrNotFound, p.upgrade.Require)
+	}
+	if _, ok := s.processSheets[p.restoration.Require]; cmd.DoRestoration && !ok {
+		return fmt.Errorf("%w: no process sheet for restoration product [%d]", ErrNotFound, p.restoration.Require)
+	}
 	investmentRequests, err := p.HandleCmd(cmd)
 	if err != nil {
 		return err
 	}
 	for _, r := range investmentRequests {
-		id := s.idGen.New()
-		s.orders[id] = NewInvestmentOrder(id, MustGet(s.processSheets, r.Product), r)
+		capacity := p.upgrade.Increases
+		if r.Type == InvestmentTypeRestoration {
+			capacity = p.restoration.Restores
+		}
+		s.events.Publish(InvestmentRequested{id, r.Type, r.Product, capacity})
+		orderId := s.idGen.New()
+		s.orders[orderId] = NewInvestmentOrder(orderId, MustGet(s.processSheets, r.Product), r)
+		s.events.Publish(OrderCreated{OrderId: orderId, ProducerId: id, Product: r.Product})
 	}
 	return nil
 }
 
 func (s *System) placeComsumersOrders() {
-	for _, c := range s.consumers {
-		for _, request := range c.Order() {
+	for _, consumerId := range slices.Sorted(maps.Keys(s.consumers)) {
+		for _, request := range s.consumers[consumerId].Order() {
 			id := s.idGen.New()
-			order := NewConsumerOrder(id, MustGet(s.processSheets, request.Product), request)
-			s.orders[id] = order
-			logEvent("system.order.placed",
-				withOrderId(id),
-				withConsumerId(request.ConsumerId),
-				withProduct(request.Product),
-				withTokens(request.Tokens))
+			s.orders[id] = NewConsumerOrder(id, MustGet(s.processSheets, request.Product), request)
+			s.events.Publish(OrderCreated{OrderId: id, ConsumerId: request.ConsumerId, Product: request.Product, Tokens: request.Tokens})
 		}
 	}
 }
 
-func (s *System) emit() {
+// emit returns the tokens emitted to every consumer
+func (s *System) emit() Tokens {
 	s.investmentFund = s.cycleEmission / 2
 	if len(s.consumers) == 0 {
-		return
+		return 0
 	}
 	consumerTokens := s.cycleEmission / 2 / Tokens(len(s.consumers))
-	logEvent("system.tokens.emitted",
-		withTokens(s.cycleEmission),
-		withTokens(s.investmentFund),
-		withTokens(consumerTokens),
-		slog.Int("consumers", len(s.consumers)))
 	for _, c := range s.consumers {
 		c.Emit(consumerTokens)
 	}
+	return consumerTokens
 }
 
 func (s *System) startCycle() {
 	s.state = SystemStateOrdersPlacement
-	logEvent("system.cycle.started",
-		withState(s.state),
-		withCycleCounter(s.cycleCounter))
-	s.emit()
-	s.placeComsumersOrders()
+	consumerTokens := s.emit()
 	s.cycleCounter++
+	s.events.Publish(CycleStarted{s.cycleCounter, s.cycleEmission, s.investmentFund, consumerTokens})
+	s.placeComsumersOrders()
 }
 
-func distibuteInvestmentFund(orders map[OrderId]*Order, investmentFund Tokens) {
+// distibuteInvestmentFund funds the investment orders and returns the undistributed remainder
+func (s *System) distibuteInvestmentFund() Tokens {
 	// Make funding
 	// Distibute investment fund accodingly producer's cut off price  (capacity deficit)
 	totalCutOffSum := CapacityUnitPrice(0)
 	nanCount := 0
-	unfundedOrders := 0
-
-	logEvent("system.investment.distribution.started",
-		withTokens(investmentFund))
 
-	for _, order := range orders {
+	orderIds := slices.Sorted(maps.Keys(s.orders))
+	for _, orderId := range orderIds {
+		order := s.orders[orderId]
 		if !order.RequiresFunding() {
 			continue
 		}
-		unfundedOrders++
 		if !order.CutOffPrice().IsNaN() {
 			totalCutOffSum += order.CutOffPrice()
 		} else {
@@ -212,53 +243,31 @@ func distibuteInvestmentFund(orders map[OrderId]*Order, investmentFund Tokens) {
 		}
 	}
 
-	logEvent("system.investment.distribution.calculated",
-		slog.Float64("totalCutOffSum", float64(totalCutOffSum)),
-		slog.Int("nanPriceOrders", nanCount),
-		slog.Int("unfundedOrders", unfundedOrders))
-
-	remains := investmentFund
-	for orderId, order := range orders {
+	remains := s.investmentFund
+	fund := func(orderId OrderId, funds Tokens) {
+		order := s.orders[orderId]
+		order.Fund(funds)
+		remains -= funds
+		s.events.Publish(InvestmentFunded{orderId, order.investmentRequest.ProducerId, funds, order.CutOffPrice()})
+	}
+	for _, orderId := range orderIds {
+		order := s.orders[orderId]
 		if !order.RequiresFunding() || order.CutOffPrice().IsNaN() {
 			continue
 		}
-		funds := Tokens(float32(order.CutOffPrice()) * float32(investmentFund) / float32(totalCutOffSum))
-		order.Fund(funds)
-		remains -= funds
-
-		logEvent("system.investment.order.funded",
-			withOrderId(orderId),
-			withTokens(funds),
-			withCutOffPrice(order.CutOffPrice()))
+		fund(orderId, Tokens(float32(order.CutOffPrice())*float32(s.investmentFund)/float32(totalCutOffSum)))
 	}
 	if nanCount == 0 {
-		if remains > 0 {
-			logEvent("system.investment.distribution.completed",
-				withTokens(remains),
-				slog.String("status", "withRemainder"))
-		} else {
-			logEvent("system.investment.distribution.completed",
-				slog.String("status", "fullyDistributed"))
-		}
-		return
+		return remains
 	}
 
 	funds := remains / Tokens(nanCount)
-	for orderId, order := range orders {
-		if !order.RequiresFunding() {
-			continue
+	for _, orderId := range orderIds {
+		if s.orders[orderId].RequiresFunding() {
+			fund(orderId, funds)
 		}
-		order.Fund(funds)
-		remains -= funds
-
-		logEvent("system.investment.order.funded.nan",
-			withOrderId(orderId),
-			withTokens(funds))
 	}
-
-	logEvent("system.investment.distribution.completed",
-		withTokens(remains),
-		slog.String("status", "nanDistributed"))
+	return remains
 }
 
 func (s *System) StartOrdering() error {
@@ -266,37 +275,31 @@ func (s *System) StartOrdering() error {
 		return ErrWrongState
 	}
 
-	logEvent("system.ordering.started",
-		withState(s.state),
-		withTokens(s.investmentFund),
-		slog.Int("orders", len(s.orders)))
-
-	distibuteInvestmentFund(s.orders, s.investmentFund)
+	remains := s.distibuteInvestmentFund()
 
 	// Place orders
-	ordersByAgent := make(map[OrderingAgentId]int)
-	for _, order := range s.orders {
+	for _, id := range slices.Sorted(maps.Keys(s.orders)) {
+		order := s.orders[id]
 		agentId := order.AgentId()
-		ordersByAgent[agentId]++
-		MustGet(s.orderingAgents, agentId).PlaceOrder(order.Info())
-	}
-
-	for agentId, count := range ordersByAgent {
-		logEvent("system.orders.distributed",
-			slog.String("agentId", string(agentId)),
-			slog.Int("orderCount", count))
+		info := order.Info()
+		MustGet(s.orderingAgents, agentId).PlaceOrder(info)
+		if !info.Fulfilled() {
+			s.events.Publish(OrderPlaced{agentId, id, info.Tokens, info.Required})
+		}
 	}
 
 	s.state = SystemStateOrdering
-
-	logEvent("system.ordering.state.changed",
-		withState(s.state))
-
+	s.events.Publish(OrderingStarted{s.cycleCounter, len(s.orders), remains})
 	return nil
 }
 
 type CycleResult struct {
-	Score Score
+	Score Score `json:"score"`
+	// AgentScores splits the score by the ordering agents of the scored orders
+	AgentScores map[OrderingAgentId]Score `json:"agentScores,omitempty"`
+	// Completed and Rejected count the finished requests by the ordering agents, timed out requests are rejected
+	Completed map[OrderingAgentId]uint `json:"completed,omitempty"`
+	Rejected  map[OrderingAgentId]uint `json:"rejected,omitempty"`
 }
 
 func (s *System) CompleteCycle() (CycleResult, error) {
@@ -304,63 +307,73 @@ func (s *System) CompleteCycle() (CycleResult, error) {
 		return CycleResult{}, ErrWrongState
 	}
 
-	logEvent("system.cycle.completing",
-		withCycleCounter(s.cycleCounter))
-
 	for _, oa := range s.orderingAgents {
 		oa.CompleteCycle()
 	}
 
-	for _, p := range s.producingAgents {
+	for _, prodId := range slices.Sorted(maps.Keys(s.producingAgents)) {
+		p := s.producingAgents[prodId]
+		available := p.producerState.capacity
 		result := p.Produce()
+		record := newMarketRecord(s.cycleCounter, p, available, result)
+		s.marketHistory = append(s.marketHistory, record)
+		s.events.Publish(ProductionCompleted(record))
 		for _, bid := range result.Processing {
-			MustGet(s.orders, bid.OrderId).Processing(bid.CapacityType, bid.Tokens)
-			logEvent("system.order.processing",
-				withOrderId(bid.OrderId),
-				withCapacityType(bid.CapacityType),
-				withTokens(bid.Tokens))
+			order, ok := s.liveOrder(bid)
+			if !ok {
+				continue
+			}
+			order.Processing(bid.CapacityType, bid.Tokens)
+			s.events.Publish(PartProcessing{bid.OrderId, bid.CapacityType, bid.Tokens})
 		}
 		for _, bid := range result.Completed {
-			MustGet(s.orders, bid.OrderId).Completed(bid.CapacityType, bid.Tokens)
-			logEvent("system.order.completed",
-				withOrderId(bid.OrderId),
-				withCapacityType(bid.CapacityType),
-				withTokens(bid.Tokens))
+			order, ok := s.liveOrder(bid)
+			if !ok {
+				continue
+			}
+			order.Completed(bid.CapacityType, bid.Tokens)
+			s.events.Publish(PartCompleted{bid.OrderId, bid.CapacityType, bid.Tokens})
 		}
 		for _, bid := range result.Rejected {
-			MustGet(s.orders, bid.OrderId).Rejected(bid.CapacityType)
-			logEvent("system.order.rejected",
-				withOrderId(bid.OrderId),
-				withCapacityType(bid.CapacityType))
+			order, ok := s.liveOrder(bid)
+			if !ok {
+				continue
+			}
+			order.Rejected(bid.CapacityType)
+			s.events.Publish(PartRejected{bid.OrderId, bid.CapacityType})
 		}
 	}
 
 	cycleScore := Score(0)
-	for id, order := range s.orders {
+	agentScores := map[OrderingAgentId]Score{}
+	completedRequests := map[OrderingAgentId]uint{}
+	rejectedRequests := map[OrderingAgentId]uint{}
+	for _, id := range slices.Sorted(maps.Keys(s.orders)) {
+		order := s.orders[id]
+		agentId := order.AgentId()
 		score, event := order.CompleteCycle()
 		cycleScore += score
+		agentScores[agentId] += score
 		completed := true
 		switch e := event.(type) {
 		case ConsumerRequestCompleted:
-			logEvent("system.request.completed.consumer",
-				withOrderId(id),
-				withConsumerId(e.Request.ConsumerId))
+			completedRequests[agentId]++
+			s.events.Publish(OrderCompleted{id, agentId, score})
 		case InvestmentRequestCompleted:
-			logEvent("system.request.completed.investment",
-				withOrderId(id),
-				withProducerId(e.Request.ProducerId))
-			s.producingAgents[e.Request.ProducerId].InvesetmentCompleted(e.Request)
+			completedRequests[agentId]++
+			s.events.Publish(OrderCompleted{id, agentId, score})
+			p := s.producingAgents[e.Request.ProducerId]
+			p.InvesetmentCompleted(e.Request)
+			s.events.Publish(InvestmentCompleted{p.id, e.Request.Type, p.producerState.capacity, p.producerState.maxCapacity})
 		case ConsumerRequestRejected:
-			logEvent("system.request.rejected.consumer",
-				withOrderId(id),
-				withConsumerId(e.Request.ConsumerId),
-				withTokens(e.Remaining))
+			rejectedRequests[agentId]++
+			s.events.Publish(OrderRejected{id, agentId, score, e.Remaining})
 			s.consumers[e.Request.ConsumerId].Emit(e.Remaining)
 		case InvestmentRequestRejected:
-			logEvent("system.request.rejected.investment",
-				withOrderId(id),
-				withProducerId(e.Request.ProducerId))
+			rejectedRequests[agentId]++
+			s.events.Publish(OrderRejected{id, agentId, score, 0})
 			s.producingAgents[e.Request.ProducerId].InvesetmentRejected(e.Request)
+			s.events.Publish(InvestmentRejected{e.Request.ProducerId, e.Request.Type})
 		case OrderStillProcessing:
 			completed = false
 		default:
@@ -371,12 +384,28 @@ func (s *System) CompleteCycle() (CycleResult, error) {
 		}
 	}
 
-	logEvent("system.cycle.completed",
-		withCycleCounter(s.cycleCounter),
-		slog.Int("score", int(cycleScore)))
+	s.refreshProducerInfos()
+	s.events.Publish(CycleCompleted{s.cycleCounter, cycleScore, completedRequests, rejectedRequests})
 
 	s.startCycle()
-	return CycleResult{cycleScore}, nil
+	return CycleResult{cycleScore, agentScores, completedRequests, rejectedRequests}, nil
+}
+
+// liveOrder returns the order of the bid. An order may time out while a producer still works on it,
+// the work on such an order is dropped.
+func (s *System) liveOrder(bid Bid) (*Order, bool) {
+	order, ok := s.orders[bid.OrderId]
+	if !ok {
+		s.events.Publish(PartDropped{bid.OrderId, bid.CapacityType})
+	}
+	return order, ok
+}
+
+// refreshProducerInfos publishes the producers' state (capacities and cut-off prices) to the ordering agents
+func (s *System) refreshProducerInfos() {
+	s.producerInfos = lo.MapEntries(s.producingAgents, func(id ProducerId, ps *ProducingAgent) (ProducerId, ProducerInfo) {
+		return id, ps.Info()
+	})
 }
 
 func (s *System) GetProducerInfos() map[ProducerId]ProducerInfo {
@@ -391,6 +420,35 @@ func (s *System) GetOrderingAgentInfos() map[OrderingAgentId]OrderingAgentInfo {
 	return result
 }
 
+// PendingProducingAgents lists the producing agents which haven't sent a command in the investment phase yet
+func (s *System) PendingProducingAgents() []ProducerId {
+	if s.state != SystemStateOrdersPlacement {
+		return nil
+	}
+	var result []ProducerId
+	for _, id := range slices.Sorted(maps.Keys(s.producingAgents)) {
+		if !s.producingAgents[id].cmdHandled {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// PendingOrderingAgents lists the ordering agents which have incoming orders
+// but haven't sent a command in the ordering phase yet
+func (s *System) PendingOrderingAgents() []OrderingAgentId {
+	if s.state != SystemStateOrdering {
+		return nil
+	}
+	var result []OrderingAgentId
+	for _, id := range slices.Sorted(maps.Keys(s.orderingAgents)) {
+		if oa := s.orderingAgents[id]; !oa.cmdHandled && len(oa.incoming) > 0 {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
 func (s *System) GetSystemInfo() SystemInfo {
 	return SystemInfo{
 		State:        s.state,
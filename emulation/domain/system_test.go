@@ -96,7 +96,7 @@ func TestSystem(t *testing.T) {
 		And the power request is less than the producer's capacity
 		Then the product is produced in a single cycle`, func(t *testing.T) {
 
-		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{"c1": &consumer1})
+		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{"c1": &consumer1}, nil)
 		// Investment
 		pav, err := system.ProducingAgentView("p1")
 		require.NoError(t, err)
@@ -111,6 +111,7 @@ func TestSystem(t *testing.T) {
 			Incoming: map[OrderId]map[CapacityType]Capacity{
 				"0": {cfg.cpt1: 10},
 			},
+			Tokens: map[OrderId]Tokens{"0": 50},
 			Producers: map[CapacityType]map[ProducerId]ProducerInfo{
 				cfg.cpt1: {"p1": ProducerInfo{"p1", cfg.cpt1, 100, 100, UndefinedPrice}},
 			},
@@ -122,7 +123,7 @@ func TestSystem(t *testing.T) {
 		require.NoError(t, err)
 		scores, err := system.CompleteCycle()
 		require.NoError(t, err)
-		require.Equal(t, CycleResult{0}, scores)
+		require.Equal(t, CycleResult{0, map[OrderingAgentId]Score{"c1": 0}, map[OrderingAgentId]uint{"c1": 1}, map[OrderingAgentId]uint{}}, scores)
 		pav, err = system.ProducingAgentView("p1")
 		require.NoError(t, err)
 		require.Equal(t, ProducingAgentView{"p1", 100, 99, 10, 1, 50, 0, false, false}, pav)
@@ -134,7 +135,7 @@ func TestSystem(t *testing.T) {
 		Then the Upgrade is produced in 2 cycles
 		And capacity of the ordered producer is increased`, func(t *testing.T) {
 
-		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{})
+		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{}, nil)
 		// Investment
 		pav, err := system.ProducingAgentView("p1")
 		require.NoError(t, err)
@@ -149,6 +150,7 @@ func TestSystem(t *testing.T) {
 			Incoming: map[OrderId]map[CapacityType]Capacity{
 				"0": {cfg.cpt2: 200},
 			},
+			Tokens: map[OrderId]Tokens{"0": 50},
 			Producers: map[CapacityType]map[ProducerId]ProducerInfo{
 				cfg.cpt2: {"p2": ProducerInfo{"p2", cfg.cpt2, 110, 110, UndefinedPrice}},
 			},
@@ -160,7 +162,7 @@ func TestSystem(t *testing.T) {
 		require.NoError(t, err)
 		scores, err := system.CompleteCycle()
 		require.NoError(t, err)
-		require.Equal(t, CycleResult{1}, scores)
+		require.Equal(t, CycleResult{1, map[OrderingAgentId]Score{"p1": 1}, map[OrderingAgentId]uint{}, map[OrderingAgentId]uint{}}, scores)
 
 		err = system.ProducingAgentAction("p1", ProducingAgentCommand{DoUpgrade: true})
 		require.Error(t, err)
@@ -170,14 +172,156 @@ func TestSystem(t *testing.T) {
 
 		oav, err = system.OrderingAgentView("p1")
 		require.NoError(t, err)
-		require.Equal(t, OrderingAgentView{map[OrderId]map[CapacityType]Capacity{}, map[CapacityType]map[ProducerId]ProducerInfo{}}, oav)
+		require.Equal(t, OrderingAgentView{map[OrderId]map[CapacityType]Capacity{}, map[OrderId]Tokens{}, map[CapacityType]map[ProducerId]ProducerInfo{}}, oav)
 
 		scores, err = system.CompleteCycle()
 		require.NoError(t, err)
-		require.Equal(t, CycleResult{Score(0)}, scores)
+		require.Equal(t, CycleResult{0, map[OrderingAgentId]Score{"p1": 0}, map[OrderingAgentId]uint{"p1": 1}, map[OrderingAgentId]uint{}}, scores)
 
 		pav, err = system.ProducingAgentView("p1")
 		require.NoError(t, err)
 		require.Equal(t, ProducingAgentView{"p1", 150, 148, 0, 2, 50, 0, false, false}, pav)
 	})
 }
+
+func TestOrderTimeout(t *testing.T) {
+	cfg := setupTestConfig()
+	bigProduct := Product(3)
+	cfg.config.ProcessSheets = append(cfg.config.ProcessSheets, ProcessSheet{bigProduct, map[CapacityType]Capacity{cfg.cpt1: 400}})
+	consumer1 := TestConsumer{id: "c1", products: []Product{bigProduct}}
+
+	t.Run(`Given an order requiring more capacity than the producer makes in three cycles
+		When the order times out
+		Then the producer's further work on the order is dropped`, func(t *testing.T) {
+
+		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{"c1": &consumer1}, nil)
+		err := system.StartOrdering()
+		require.NoError(t, err)
+		err = system.OrderingAgentAction("c1", OrderingAgentCommand{
+			Orders: map[OrderId]map[ProducerId]Tokens{
+				"0": {"p1": 50},
+			}})
+		require.NoError(t, err)
+		for range 4 {
+			require.NotPanics(t, func() {
+				_, err = system.CompleteCycle()
+			})
+			require.NoError(t, err)
+			err = system.StartOrdering()
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestMarketHistory(t *testing.T) {
+	cfg := setupTestConfig()
+	consumer1 := TestConsumer{id: "c1", products: []Product{cfg.consumerProduct}}
+
+	t.Run(`Given the system with a consumer
+		When a cycle is completed
+		Then a market record is stored for every producer
+		And ordering agents see the updated cut off price`, func(t *testing.T) {
+
+		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{"c1": &consumer1}, nil)
+		err := system.StartOrdering()
+		require.NoError(t, err)
+		err = system.OrderingAgentAction("c1", OrderingAgentCommand{
+			Orders: map[OrderId]map[ProducerId]Tokens{
+				"0": {"p1": 50},
+			}})
+		require.NoError(t, err)
+		_, err = system.CompleteCycle()
+		require.NoError(t, err)
+
+		require.True(t, cmp.Equal([]MarketRecord{
+			{1, "p1", cfg.cpt1, 5, 100, 10, 10, 0, 50},
+			{1, "p2", cfg.cpt2, UndefinedPrice, 110, 0, 0, 0, 0},
+		}, system.MarketHistory(0, 1)))
+		require.Empty(t, system.MarketHistory(2, 10))
+
+		err = system.StartOrdering()
+		require.NoError(t, err)
+		oav, err := system.OrderingAgentView("c1")
+		require.NoError(t, err)
+		require.True(t, cmp.Equal(ProducerInfo{"p1", cfg.cpt1, 100, 99, 5}, oav.Producers[cfg.cpt1]["p1"]))
+	})
+
+	t.Run(`Given a producer that traded in the previous cycle
+		When a cycle without bids is completed
+		Then its record has no cut off price
+		And ordering agents still see the price of the last accepted bid`, func(t *testing.T) {
+
+		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{"c1": &consumer1}, nil)
+		require.NoError(t, system.StartOrdering())
+		require.NoError(t, system.OrderingAgentAction("c1", OrderingAgentCommand{
+			Orders: map[OrderId]map[ProducerId]Tokens{
+				"0": {"p1": 50},
+			}}))
+		_, err := system.CompleteCycle()
+		require.NoError(t, err)
+		require.NoError(t, system.StartOrdering())
+		_, err = system.CompleteCycle()
+		require.NoError(t, err)
+
+		require.True(t, cmp.Equal([]MarketRecord{
+			{2, "p1", cfg.cpt1, UndefinedPrice, 99, 0, 0, 0, 0},
+			{2, "p2", cfg.cpt2, UndefinedPrice, 108, 0, 0, 0, 0},
+		}, system.MarketHistory(2, 2)))
+		require.Len(t, system.MarketHistory(1, 1), 2)
+		require.Len(t, system.MarketHistory(0, 10), 4)
+		require.Empty(t, system.MarketHistory(3, 10))
+
+		require.NoError(t, system.StartOrdering())
+		oav, err := system.OrderingAgentView("c1")
+		require.NoError(t, err)
+		require.Equal(t, CapacityUnitPrice(5), oav.Producers[cfg.cpt1]["p1"].CutOffPrice)
+	})
+}
+
+func TestPendingAgents(t *testing.T) {
+	cfg := setupTestConfig()
+
+	t.Run(`Given a new system
+		When some agents send their commands
+		Then the rest are pending in their phase`, func(t *testing.T) {
+
+		consumer := TestConsumer{id: "c1", products: []Product{cfg.consumerProduct}}
+		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{"c1": &consumer}, nil)
+		require.Equal(t, []ProducerId{"p1", "p2"}, system.PendingProducingAgents())
+		require.Nil(t, system.PendingOrderingAgents())
+
+		require.NoError(t, system.ProducingAgentAction("p2", ProducingAgentCommand{}))
+		require.Equal(t, []ProducerId{"p1"}, system.PendingProducingAgents())
+
+		require.NoError(t, system.StartOrdering())
+		require.Nil(t, system.PendingProducingAgents())
+		require.Equal(t, []OrderingAgentId{"c1"}, system.PendingOrderingAgents())
+
+		require.NoError(t, system.OrderingAgentAction("c1", OrderingAgentCommand{
+			Orders: map[OrderId]map[ProducerId]Tokens{"0": {"p1": 50}},
+		}))
+		require.Empty(t, system.PendingOrderingAgents())
+
+		_, err := system.CompleteCycle()
+		require.NoError(t, err)
+		require.Equal(t, []ProducerId{"p1", "p2"}, system.PendingProducingAgents())
+	})
+	t.Run(`Given an ordering agent with incoming orders
+		When it passes
+		Then it isn't pending any more and the cycle completes without its bids`, func(t *testing.T) {
+
+		consumer := TestConsumer{id: "c1", products: []Product{cfg.consumerProduct}}
+		system := NewSystem(&TestIdGenerator{}, cfg.config, map[ConsumerId]Consumer{"c1": &consumer}, nil)
+		require.ErrorIs(t, system.OrderingAgentPass("c1"), ErrWrongState)
+		require.NoError(t, system.StartOrdering())
+
+		require.NoError(t, system.OrderingAgentPass("c1"))
+		require.Empty(t, system.PendingOrderingAgents())
+		require.Error(t, system.OrderingAgentPass("c1"))
+		require.ErrorIs(t, system.OrderingAgentPass("c2"), ErrNotFound)
+
+		result, err := system.CompleteCycle()
+		require.NoError(t, err)
+		require.Empty(t, result.Completed)
+	})
+}
@@ -0,0 +1,231 @@
+package evolution
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"emulation/domain"
+	"emulation/generator"
+	"emulation/simulation"
+
+	"github.com/samber/lo"
+)
+
+// Settings of an evolutionary run
+type Settings struct {
+	Genes          []Gene  `json:"genes"`
+	Population     int     `json:"population"`
+	Generations    int     `json:"generations"`
+	Elite          int     `json:"elite"`
+	TournamentSize int     `json:"tournamentSize"`
+	MutationRate   float64 `json:"mutationRate"`
+	MutationScale  float64 `json:"mutationScale"`
+	Cycles         uint    `json:"cycles"`
+	Seeds          int     `json:"seeds"`
+	Seed           uint64  `json:"seed"`
+	Workers        int     `json:"workers,omitempty"`
+	// Economies, if set, are generated to evaluate the genomes on in addition to the configuration
+	Economies *Economies `json:"economies,omitempty"`
+}
+
+// Economies are the generated environments of the evolution, so that the strategies don't overfit one economy.
+// The economy i is generated with the seed of the params plus i, its bots are of the params.
+type Economies struct {
+	Count  int              `json:"count"`
+	Params generator.Params `json:"params"`
+}
+
+// Validate checks the settings and the genes against the configuration and the generated economies
+func (s *Settings) Validate(config *domain.Configuration) error {
+	_, err := s.validate(config)
+	return err
+}
+
+// validate returns the configurations to evaluate the genomes on
+func (s *Settings) validate(config *domain.Configuration) ([]*domain.Configuration, error) {
+	if s.Population < 2 {
+		return nil, fmt.Errorf("population must be at least 2, got %d", s.Population)
+	}
+	if s.Generations < 1 {
+		return nil, fmt.Errorf("generations must be positive, got %d", s.Generations)
+	}
+	if s.Elite < 0 || s.Elite >= s.Population {
+		return nil, fmt.Errorf("elite must be within [0, %d), got %d", s.Population, s.Elite)
+	}
+	if s.TournamentSize < 1 {
+		return nil, fmt.Errorf("tournament size must be positive, got %d", s.TournamentSize)
+	}
+	if s.MutationRate < 0 || s.MutationRate > 1 {
+		return nil, fmt.Errorf("mutation rate must be within [0, 1], got %v", s.MutationRate)
+	}
+	if s.MutationScale < 0 {
+		return nil, fmt.Errorf("mutation scale must not be negative, got %v", s.MutationScale)
+	}
+	if s.Cycles == 0 || s.Seeds < 1 {
+		return nil, fmt.Errorf("cycles and seeds must be positive, got %d and %d", s.Cycles, s.Seeds)
+	}
+	if s.Economies != nil {
+		if s.Economies.Count < 1 {
+			return nil, fmt.Errorf("economies count must be positive, got %d", s.Economies.Count)
+		}
+		if err := s.Economies.Params.Validate(); err != nil {
+			return nil, fmt.Errorf("economies: %w", err)
+		}
+	}
+	configs, err := environments(config, s.Economies)
+	if err != nil {
+		return nil, err
+	}
+	return configs, validateGenes(configs, s.Genes)
+}
+
+// Individual is an evaluated genome. The fitness is the negated mean cycle score over all the seeds
+// and the economies, so the higher the better.
+type Individual struct {
+	Genome  Genome  `json:"genome"`
+	Fitness float64 `json:"fitness"`
+}
+
+// Generation is the outcome of a single generation
+type Generation struct {
+	Generation  int                `json:"generation"`
+	Best        Individual         `json:"best"`
+	BestParams  map[string]float64 `json:"bestParams"`
+	MeanFitness float64            `json:"meanFitness"`
+}
+
+// Evolve runs the generations starting from a random population and returns the best individual found.
+// Every generation is reported to onGeneration, an error returned by it stops the run.
+func Evolve(config *domain.Configuration, settings Settings, onGeneration func(Generation) error) (Individual, error) {
+	configs, err := settings.validate(config)
+	if err != nil {
+		return Individual{}, err
+	}
+	e := &evolution{configs, settings, rand.New(rand.NewPCG(settings.Seed, 0))}
+
+	genomes := make([]Genome, settings.Population)
+	for i := range genomes {
+		genomes[i] = e.randomGenome()
+	}
+	population, err := e.evaluate(genomes)
+	if err != nil {
+		return Individual{}, err
+	}
+	for generation := 0; ; generation++ {
+		slices.SortStableFunc(population, func(a, b Individual) int {
+			return cmp.Compare(b.Fitness, a.Fitness)
+		})
+		report := Generation{
+			Generation:  generation,
+			Best:        population[0],
+			BestParams:  population[0].Genome.Named(settings.Genes),
+			MeanFitness: lo.MeanBy(population, func(i Individual) float64 { return i.Fitness }),
+		}
+		if err := onGeneration(report); err != nil {
+			return population[0], err
+		}
+		if generation+1 == settings.Generations {
+			return population[0], nil
+		}
+
+		children := make([]Genome, settings.Population-settings.Elite)
+		for i := range children {
+			children[i] = e.mutate(e.crossover(e.tournament(population), e.tournament(population)))
+		}
+		evaluated, err := e.evaluate(children)
+		if err != nil {
+			return population[0], err
+		}
+		population = append(population[:settings.Elite], evaluated...)
+	}
+}
+
+type evolution struct {
+	// configs are the configuration and the generated economies
+	configs  []*domain.Configuration
+	settings Settings
+	rnd      *rand.Rand
+}
+
+// environments returns the configuration followed by the generated economies
+func environments(config *domain.Configuration, economies *Economies) ([]*domain.Configuration, error) {
+	result := []*domain.Configuration{config}
+	if economies == nil {
+		return result, nil
+	}
+	for i := range economies.Count {
+		params := economies.Params
+		params.Seed += uint64(i)
+		economy, err := generator.Generate(params)
+		if err != nil {
+			return nil, fmt.Errorf("economy %d: %w", i, err)
+		}
+		result = append(result, economy)
+	}
+	return result, nil
+}
+
+func (e *evolution) randomGenome() Genome {
+	return lo.Map(e.settings.Genes, func(g Gene, _ int) float64 {
+		return g.clamp(g.Min + e.rnd.Float64()*(g.Max-g.Min))
+	})
+}
+
+// tournament picks the fittest of randomly chosen individuals
+func (e *evolution) tournament(population []Individual) Genome {
+	best := population[e.rnd.IntN(len(population))]
+	for range e.settings.TournamentSize - 1 {
+		if candidate := population[e.rnd.IntN(len(population))]; candidate.Fitness > best.Fitness {
+			best = candidate
+		}
+	}
+	return best.Genome
+}
+
+// crossover takes every gene from one of the parents at random
+func (e *evolution) crossover(a, b Genome) Genome {
+	return lo.Map(a, func(v float64, i int) float64 {
+		if e.rnd.IntN(2) == 0 {
+			return v
+		}
+		return b[i]
+	})
+}
+
+// mutate shifts genes by a normally distributed step scaled to the gene's range
+func (e *evolution) mutate(genome Genome) Genome {
+	for i, g := range e.settings.Genes {
+		if e.rnd.Float64() < e.settings.MutationRate {
+			genome[i] = g.clamp(genome[i] + e.rnd.NormFloat64()*e.settings.MutationScale*(g.Max-g.Min))
+		}
+	}
+	return genome
+}
+
+// evaluate runs the headless simulations of the genomes in parallel
+func (e *evolution) evaluate(genomes []Genome) ([]Individual, error) {
+	result := make([]Individual, len(genomes))
+	err := simulation.ForEach(len(genomes), e.settings.Workers, func(i int) error {
+		var err error
+		result[i], err = e.fitness(genomes[i])
+		return err
+	})
+	return result, err
+}
+
+func (e *evolution) fitness(genome Genome) (Individual, error) {
+	total := 0.0
+	for _, config := range e.configs {
+		config = Apply(config, e.settings.Genes, genome)
+		for i := range e.settings.Seeds {
+			result, err := simulation.Run(config, simulation.Options{Cycles: e.settings.Cycles, Seed: e.settings.Seed + uint64(i)})
+			if err != nil {
+				return Individual{}, fmt.Errorf("genome %v: %w", genome.Named(e.settings.Genes), err)
+			}
+			total += result.Summary.MeanScore
+		}
+	}
+	return Individual{genome, -total / float64(e.settings.Seeds*len(e.configs))}, nil
+}
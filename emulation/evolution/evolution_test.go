@@ -0,0 +1,151 @@
+package evolution
+
+import (
+	"testing"
+
+	"emulation/domain"
+	"emulation/domain/domaintest"
+	"emulation/generator"
+	"emulation/strategy"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig is the shared economy with upgrading producers and all agents controlled by bots
+func testConfig() *domain.Configuration {
+	config := domaintest.Economy()
+	config.ProducerConfigs[0].Upgrade = domain.Upgrade{Require: 2, Increases: 20}
+	config.ProducerConfigs[1].Upgrade = domain.Upgrade{Require: 1, Increases: 20}
+	config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{
+		"c1": {Name: strategy.FixedMarkupName},
+		"c2": {Name: strategy.FixedMarkupName},
+		"p1": {Name: strategy.ProportionalToPriceName},
+		"p2": {Name: strategy.ProportionalToPriceName},
+	}
+	config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{
+		"p1": {Name: strategy.ThresholdName, Params: map[string]float64{"upgradeDemand": 2}},
+		"p2": {Name: strategy.ThresholdName},
+	}
+	return config
+}
+
+var testGenes = []Gene{
+	{Strategy: strategy.FixedMarkupName, Param: "markup", Min: 0, Max: 1},
+	{Strategy: strategy.ThresholdName, Param: "restoreBelow", Min: 0, Max: 1},
+	{Strategy: strategy.ThresholdName, Param: "upgradeCycles", Min: 1, Max: 5, Integer: true},
+}
+
+func TestApply(t *testing.T) {
+	config := testConfig()
+	result := Apply(config, testGenes, Genome{0.3, 0.7, 2})
+
+	require.Equal(t, domain.StrategyConfig{Name: strategy.FixedMarkupName, Params: map[string]float64{"markup": 0.3}}, result.OrderingBots["c2"])
+	require.Equal(t, domain.StrategyConfig{Name: strategy.ProportionalToPriceName}, result.OrderingBots["p1"])
+	require.Equal(t, domain.StrategyConfig{Name: strategy.ThresholdName, Params: map[string]float64{"upgradeDemand": 2, "restoreBelow": 0.7, "upgradeCycles": 2}}, result.ProducingBots["p1"])
+	require.Equal(t, domain.StrategyConfig{Name: strategy.ThresholdName, Params: map[string]float64{"upgradeDemand": 2}}, config.ProducingBots["p1"])
+}
+
+func TestEvolve(t *testing.T) {
+	settings := Settings{
+		Genes:          testGenes,
+		Population:     6,
+		Generations:    4,
+		Elite:          1,
+		TournamentSize: 2,
+		MutationRate:   0.5,
+		MutationScale:  0.2,
+		Cycles:         30,
+		Seeds:          2,
+		Seed:           5,
+	}
+
+	t.Run(`Given evolution settings with an elite
+		When the evolution is run
+		Then every generation is reported
+		And the best fitness never gets worse`, func(t *testing.T) {
+
+		generations := []Generation{}
+		best, err := Evolve(testConfig(), settings, func(g Generation) error {
+			generations = append(generations, g)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, generations, 4)
+		for i := 1; i < len(generations); i++ {
+			require.GreaterOrEqual(t, generations[i].Best.Fitness, generations[i-1].Best.Fitness)
+		}
+		require.Equal(t, generations[3].Best, best)
+		require.Equal(t, float64(int(best.Genome[2])), best.Genome[2])
+	})
+
+	t.Run(`Given the same settings
+		When the evolution is run with a different number of workers
+		Then the result is the same`, func(t *testing.T) {
+
+		single := settings
+		single.Workers = 1
+		first, err := Evolve(testConfig(), single, func(Generation) error { return nil })
+		require.NoError(t, err)
+		second, err := Evolve(testConfig(), settings, func(Generation) error { return nil })
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+	})
+
+	t.Run(`Given settings with generated economies running the evolved strategies
+		When the evolution is run
+		Then the genomes are evaluated on the economies too`, func(t *testing.T) {
+
+		withEconomies := settings
+		withEconomies.Generations = 1
+		withEconomies.Economies = &Economies{Count: 2, Params: generator.Params{
+			CapacityTypes: 3, ProducersPerType: 1, Products: 4, Complexity: []float64{1, 1},
+			Requirement: generator.Range{Min: 5, Max: 40}, Degradation: generator.Range{Min: 2, Max: 8},
+			CrossDependency: 0.5, Consumers: 2, ProductsPerConsumer: 2, Headroom: 1.5, Emission: 3,
+			Bots: generator.Bots{
+				Consumers:   domain.StrategyConfig{Name: strategy.FixedMarkupName},
+				Producers:   domain.StrategyConfig{Name: strategy.ProportionalToPriceName},
+				Investments: domain.StrategyConfig{Name: strategy.ThresholdName},
+			},
+		}}
+		withoutEconomies := withEconomies
+		withoutEconomies.Economies = nil
+
+		first, err := Evolve(testConfig(), withEconomies, func(Generation) error { return nil })
+		require.NoError(t, err)
+		second, err := Evolve(testConfig(), withoutEconomies, func(Generation) error { return nil })
+		require.NoError(t, err)
+		require.NotEqual(t, first.Fitness, second.Fitness)
+
+		withEconomies.Economies.Count = 0
+		_, err = Evolve(testConfig(), withEconomies, func(Generation) error { return nil })
+		require.ErrorContains(t, err, "economies count must be positive")
+	})
+
+	t.Run(`Given a gene of a strategy no bot runs
+		When the evolution is run
+		Then an error is returned`, func(t *testing.T) {
+
+		invalid := settings
+		invalid.Genes = []Gene{{Strategy: strategy.NeverInvestName, Param: "x", Min: 0, Max: 1}}
+		_, err := Evolve(testConfig(), invalid, func(Generation) error { return nil })
+		require.Error(t, err)
+	})
+
+	t.Run(`Given generated economies whose bots don't run the strategy of a gene
+		When the evolution is run
+		Then an error is returned`, func(t *testing.T) {
+
+		invalid := settings
+		invalid.Economies = &Economies{Count: 1, Params: generator.Params{
+			CapacityTypes: 3, ProducersPerType: 1, Products: 4, Complexity: []float64{1, 1},
+			Requirement: generator.Range{Min: 5, Max: 40}, Degradation: generator.Range{Min: 2, Max: 8},
+			CrossDependency: 0.5, Consumers: 2, ProductsPerConsumer: 2, Headroom: 1.5, Emission: 3,
+			Bots: generator.Bots{
+				Consumers: domain.StrategyConfig{Name: strategy.FixedMarkupName},
+				Producers: domain.StrategyConfig{Name: strategy.ProportionalToPriceName},
+			},
+		}}
+		_, err := Evolve(testConfig(), invalid, func(Generation) error { return nil })
+		require.ErrorContains(t, err, "no bot of economy 0 runs strategy [threshold]")
+	})
+}
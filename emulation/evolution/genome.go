@@ -0,0 +1,113 @@
+package evolution
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+
+	"emulation/domain"
+)
+
+// Gene is a tunable parameter of a built-in strategy. Its value is applied to every bot running the strategy.
+type Gene struct {
+	Strategy string  `json:"strategy"`
+	Param    string  `json:"param"`
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+	Integer  bool    `json:"integer,omitempty"`
+}
+
+func (g Gene) Name() string {
+	return g.Strategy + "." + g.Param
+}
+
+// clamp keeps the value within the gene's range, integer genes are rounded
+func (g Gene) clamp(v float64) float64 {
+	if g.Integer {
+		v = math.Round(v)
+	}
+	return min(max(v, g.Min), g.Max)
+}
+
+// Genome holds a value of every gene, in the order of the genes
+type Genome []float64
+
+// Named returns the genome values by the gene names
+func (g Genome) Named(genes []Gene) map[string]float64 {
+	result := make(map[string]float64, len(genes))
+	for i, gene := range genes {
+		result[gene.Name()] = g[i]
+	}
+	return result
+}
+
+// Apply returns a copy of the config with the genome values set as parameters of the matching bots
+func Apply(config *domain.Configuration, genes []Gene, genome Genome) *domain.Configuration {
+	result := *config
+	result.OrderingBots = cloneBots(config.OrderingBots)
+	result.ProducingBots = cloneBots(config.ProducingBots)
+	for i, gene := range genes {
+		setParam(result.OrderingBots, gene, genome[i])
+		setParam(result.ProducingBots, gene, genome[i])
+	}
+	return &result
+}
+
+func cloneBots[K comparable](bots map[K]domain.StrategyConfig) map[K]domain.StrategyConfig {
+	result := make(map[K]domain.StrategyConfig, len(bots))
+	for id, sc := range bots {
+		result[id] = domain.StrategyConfig{Name: sc.Name, Params: maps.Clone(sc.Params)}
+	}
+	return result
+}
+
+func setParam[K comparable](bots map[K]domain.StrategyConfig, gene Gene, value float64) {
+	for id, sc := range bots {
+		if sc.Name != gene.Strategy {
+			continue
+		}
+		if sc.Params == nil {
+			sc.Params = map[string]float64{}
+		}
+		sc.Params[gene.Param] = value
+		bots[id] = sc
+	}
+}
+
+// validateGenes checks that every strategy of the genes is run by a bot of every evaluated configuration,
+// the first configuration is the base one and the others are the generated economies
+func validateGenes(configs []*domain.Configuration, genes []Gene) error {
+	if len(genes) == 0 {
+		return errors.New("no genes to evolve")
+	}
+	names := map[string]bool{}
+	for _, gene := range genes {
+		if names[gene.Name()] {
+			return fmt.Errorf("duplicate gene %s", gene.Name())
+		}
+		names[gene.Name()] = true
+		if gene.Min > gene.Max {
+			return fmt.Errorf("gene %s: min %v is greater than max %v", gene.Name(), gene.Min, gene.Max)
+		}
+	}
+	for i, config := range configs {
+		used := map[string]bool{}
+		for _, sc := range config.OrderingBots {
+			used[sc.Name] = true
+		}
+		for _, sc := range config.ProducingBots {
+			used[sc.Name] = true
+		}
+		for _, gene := range genes {
+			if used[gene.Strategy] {
+				continue
+			}
+			if i == 0 {
+				return fmt.Errorf("gene %s: no bot runs strategy [%s]", gene.Name(), gene.Strategy)
+			}
+			return fmt.Errorf("gene %s: no bot of economy %d runs strategy [%s]", gene.Name(), i-1, gene.Strategy)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package experiment
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"emulation/domain"
+	"emulation/simulation"
+
+	"github.com/samber/lo"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Sweep runs every point of the parameters grid with the same seeds
+type Sweep struct {
+	Parameters []Parameter `json:"parameters"`
+	Seeds      int         `json:"seeds"`
+	Cycles     uint        `json:"cycles"`
+	Seed       uint64      `json:"seed"`
+	Workers    int         `json:"workers,omitempty"`
+}
+
+// Metric is the mean of a run outcome over the seeds with its 95% confidence interval
+type Metric struct {
+	Mean float64
+	Low  float64
+	High float64
+}
+
+// Point is the aggregated outcome of a single grid point
+type Point struct {
+	Values        []float64
+	Runs          int
+	MeanScore     Metric
+	AcceptedShare Metric
+	FinalCapacity Metric
+}
+
+// Run simulates every grid point with every seed in parallel, the points come in the grid order
+// with the last parameter changing the fastest
+func Run(config *domain.Configuration, sweep Sweep) ([]Point, error) {
+	if sweep.Seeds < 1 || sweep.Cycles == 0 {
+		return nil, fmt.Errorf("seeds and cycles must be positive, got %d and %d", sweep.Seeds, sweep.Cycles)
+	}
+	if len(sweep.Parameters) == 0 {
+		return nil, errors.New("no parameters to sweep")
+	}
+	grid, err := newGrid(sweep.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	configs := make([]*domain.Configuration, len(grid))
+	for i, values := range grid {
+		configs[i] = clone(config)
+		for j, p := range sweep.Parameters {
+			if err := apply(configs[i], p.Path, values[j]); err != nil {
+				return nil, err
+			}
+		}
+		if err := configs[i].Validate(); err != nil {
+			return nil, fmt.Errorf("point %v: %w", values, err)
+		}
+	}
+
+	summaries := make([]simulation.Summary, len(grid)*sweep.Seeds)
+	err = simulation.ForEach(len(summaries), sweep.Workers, func(i int) error {
+		point, seed := i/sweep.Seeds, sweep.Seed+uint64(i%sweep.Seeds)
+		result, err := simulation.Run(configs[point], simulation.Options{Cycles: sweep.Cycles, Seed: seed})
+		if err != nil {
+			return fmt.Errorf("point %v, seed %d: %w", grid[point], seed, err)
+		}
+		summaries[i] = result.Summary
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(grid, func(values []float64, i int) Point {
+		runs := summaries[i*sweep.Seeds : (i+1)*sweep.Seeds]
+		return Point{
+			Values:        values,
+			Runs:          len(runs),
+			MeanScore:     newMetric(lo.Map(runs, func(s simulation.Summary, _ int) float64 { return s.MeanScore })),
+			AcceptedShare: newMetric(lo.Map(runs, func(s simulation.Summary, _ int) float64 { return s.AcceptedShare })),
+			FinalCapacity: newMetric(lo.Map(runs, func(s simulation.Summary, _ int) float64 { return float64(s.FinalCapacity) })),
+		}
+	}), nil
+}
+
+// newGrid builds the cartesian product of the parameters' values
+func newGrid(parameters []Parameter) ([][]float64, error) {
+	grid := [][]float64{{}}
+	for _, p := range parameters {
+		points, err := p.points()
+		if err != nil {
+			return nil, err
+		}
+		next := make([][]float64, 0, len(grid)*len(points))
+		for _, values := range grid {
+			for _, v := range points {
+				next = append(next, append(append([]float64{}, values...), v))
+			}
+		}
+		grid = next
+	}
+	return grid, nil
+}
+
+// newMetric uses the Student's t-distribution, so the interval is honest for a few seeds too
+func newMetric(xs []float64) Metric {
+	mean, std := stat.MeanStdDev(xs, nil)
+	if len(xs) < 2 || math.IsNaN(std) {
+		return Metric{mean, mean, mean}
+	}
+	t := distuv.StudentsT{Mu: 0, Sigma: 1, Nu: float64(len(xs) - 1)}.Quantile(0.975)
+	margin := t * std / math.Sqrt(float64(len(xs)))
+	return Metric{mean, mean - margin, mean + margin}
+}
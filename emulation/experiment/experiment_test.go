@@ -0,0 +1,136 @@
+package experiment
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"emulation/domain"
+	"emulation/domain/domaintest"
+	"emulation/strategy"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig is the shared economy with all agents controlled by bots
+func testConfig() *domain.Configuration {
+	config := domaintest.Economy()
+	config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{
+		"c1": {Name: strategy.ProportionalToCapacityName},
+		"c2": {Name: strategy.FixedMarkupName},
+		"p1": {Name: strategy.ProportionalToPriceName},
+		"p2": {Name: strategy.ProportionalToPriceName},
+	}
+	config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{
+		"p1": {Name: strategy.RestoreBelowName},
+		"p2": {Name: strategy.RestoreBelowName},
+	}
+	return config
+}
+
+func TestGrid(t *testing.T) {
+	grid, err := newGrid([]Parameter{
+		{Path: "a", Values: []float64{1, 2}},
+		{Path: "b", From: 0, To: 0.3, Step: 0.1},
+	})
+	require.NoError(t, err)
+	require.Len(t, grid, 8)
+	require.Equal(t, []float64{1, 0}, grid[0])
+	require.Equal(t, []float64{1, 0.3}, grid[3])
+	require.Equal(t, []float64{2, 0}, grid[4])
+
+	_, err = newGrid([]Parameter{{Path: "a"}})
+	require.Error(t, err)
+}
+
+func TestPoints(t *testing.T) {
+	points, err := Parameter{Path: "a", From: 0, To: 1, Step: 0.4}.points()
+	require.NoError(t, err)
+	require.InDeltaSlice(t, []float64{0, 0.4, 0.8}, points, 1e-9)
+
+	points, err = Parameter{Path: "a", From: 0, To: 1, Step: 0.3}.points()
+	require.NoError(t, err)
+	require.InDeltaSlice(t, []float64{0, 0.3, 0.6, 0.9}, points, 1e-9)
+
+	points, err = Parameter{Path: "a", From: 1, To: 1, Step: 0.5}.points()
+	require.NoError(t, err)
+	require.Equal(t, []float64{1}, points)
+}
+
+func TestApply(t *testing.T) {
+	base := testConfig()
+	config := clone(base)
+	require.NoError(t, apply(config, "cycleEmission", 500))
+	require.NoError(t, apply(config, "producers.*.degradation", 7))
+	require.NoError(t, apply(config, "producers.p2.restoration", 30))
+	require.NoError(t, apply(config, "strategy.restore-below.below", 0.5))
+
+	require.Equal(t, domain.Tokens(500), config.CycleEmission)
+	require.Equal(t, domain.DegradationRate(7), config.ProducerConfigs[0].Degradation)
+	require.Equal(t, domain.Capacity(30), config.ProducerConfigs[1].Restoration.Restores)
+	require.Equal(t, map[string]float64{"below": 0.5}, config.ProducingBots["p1"].Params)
+
+	require.Equal(t, domain.DegradationRate(5), base.ProducerConfigs[0].Degradation)
+	require.Nil(t, base.ProducingBots["p1"].Params)
+
+	require.ErrorIs(t, apply(config, "producers.p9.capacity", 1), domain.ErrNotFound)
+	require.ErrorIs(t, apply(config, "strategy.threshold.upgradeCycles", 1), domain.ErrNotFound)
+	require.Error(t, apply(config, "producers.p1.unknown", 1))
+	require.Error(t, apply(config, "unknown", 1))
+}
+
+func TestRun(t *testing.T) {
+	sweep := Sweep{
+		Parameters: []Parameter{{Path: "producers.*.degradation", Values: []float64{2, 10}}},
+		Seeds:      4,
+		Cycles:     30,
+		Seed:       1,
+	}
+
+	t.Run(`Given a sweep over a parameter with several seeds
+		When it is run
+		Then a point per value is aggregated over all the seeds
+		And the confidence intervals contain the means`, func(t *testing.T) {
+
+		points, err := Run(testConfig(), sweep)
+		require.NoError(t, err)
+		require.Len(t, points, 2)
+		for i, p := range points {
+			require.Equal(t, sweep.Parameters[0].Values[i:i+1], p.Values)
+			require.Equal(t, 4, p.Runs)
+			for _, m := range []Metric{p.MeanScore, p.AcceptedShare, p.FinalCapacity} {
+				require.LessOrEqual(t, m.Low, m.Mean)
+				require.GreaterOrEqual(t, m.High, m.Mean)
+			}
+		}
+
+		again, err := Run(testConfig(), sweep)
+		require.NoError(t, err)
+		require.Equal(t, points, again)
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteCSV(&buf, sweep, points))
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 3)
+		require.Equal(t, "producers.*.degradation,runs,meanScore,meanScoreLow,meanScoreHigh,acceptedShare,acceptedShareLow,acceptedShareHigh,finalCapacity,finalCapacityLow,finalCapacityHigh", lines[0])
+	})
+
+	t.Run(`Given a point making the configuration invalid
+		When it is run
+		Then an error is returned`, func(t *testing.T) {
+
+		invalid := sweep
+		invalid.Parameters = []Parameter{{Path: "cycleEmission", Values: []float64{0}}}
+		_, err := Run(testConfig(), invalid)
+		require.Error(t, err)
+	})
+}
+
+func TestMetric(t *testing.T) {
+	m := newMetric([]float64{1, 2, 3})
+	require.Equal(t, 2.0, m.Mean)
+	require.InDelta(t, 2-2.4841, m.Low, 1e-3)
+	require.InDelta(t, 2+2.4841, m.High, 1e-3)
+
+	require.Equal(t, Metric{5, 5, 5}, newMetric([]float64{5}))
+}
@@ -0,0 +1,42 @@
+package experiment
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/samber/lo"
+)
+
+var metricNames = []string{"meanScore", "acceptedShare", "finalCapacity"}
+
+// WriteCSV writes a row per grid point: the parameter values, the number of runs,
+// and the mean with the confidence interval bounds of every metric
+func WriteCSV(w io.Writer, sweep Sweep, points []Point) error {
+	header := lo.Map(sweep.Parameters, func(p Parameter, _ int) string { return p.Path })
+	header = append(header, "runs")
+	for _, name := range metricNames {
+		header = append(header, name, name+"Low", name+"High")
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, p := range points {
+		row := lo.Map(p.Values, func(v float64, _ int) string { return formatFloat(v) })
+		row = append(row, strconv.Itoa(p.Runs))
+		for _, m := range []Metric{p.MeanScore, p.AcceptedShare, p.FinalCapacity} {
+			row = append(row, formatFloat(m.Mean), formatFloat(m.Low), formatFloat(m.High))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
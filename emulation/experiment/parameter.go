@@ -0,0 +1,112 @@
+package experiment
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+
+	"emulation/domain"
+)
+
+// Parameter is a configuration value swept over the listed values or over the [From, To] range with the Step,
+// To is swept only when the Step divides the range.
+//
+// Supported paths:
+//   - cycleEmission
+//   - producers.<id>.<field>, where <id> may be * for all producers
+//     and <field> is one of capacity, degradation, upgrade (capacity increase), restoration (capacity restored)
+//   - strategy.<name>.<param> sets the parameter of every bot running the strategy
+type Parameter struct {
+	Path   string    `json:"path"`
+	Values []float64 `json:"values,omitempty"`
+	From   float64   `json:"from,omitempty"`
+	To     float64   `json:"to,omitempty"`
+	Step   float64   `json:"step,omitempty"`
+}
+
+// points returns the values, or the range from From by Step, which includes To only when the Step divides the range
+func (p Parameter) points() ([]float64, error) {
+	if len(p.Values) > 0 {
+		return p.Values, nil
+	}
+	if p.Step <= 0 || p.To < p.From {
+		return nil, fmt.Errorf("parameter %s: either values or a range with a positive step are required", p.Path)
+	}
+	// the tolerance keeps the range end despite the rounding errors of the division
+	n := int(math.Floor((p.To-p.From)/p.Step + 1e-9))
+	result := make([]float64, 0, n+1)
+	for i := range n + 1 {
+		result = append(result, min(p.From+float64(i)*p.Step, p.To))
+	}
+	return result, nil
+}
+
+// apply sets the value to the configuration, which must be a copy owned by the caller
+func apply(config *domain.Configuration, path string, value float64) error {
+	parts := strings.Split(path, ".")
+	switch {
+	case len(parts) == 1 && parts[0] == "cycleEmission":
+		config.CycleEmission = domain.Tokens(math.Round(value))
+	case len(parts) == 3 && parts[0] == "producers":
+		found := false
+		for i := range config.ProducerConfigs {
+			pc := &config.ProducerConfigs[i]
+			if parts[1] != "*" && parts[1] != string(pc.Id) {
+				continue
+			}
+			found = true
+			switch parts[2] {
+			case "capacity":
+				pc.Capacity = domain.Capacity(math.Round(value))
+			case "degradation":
+				pc.Degradation = domain.DegradationRate(math.Round(value))
+			case "upgrade":
+				pc.Upgrade.Increases = domain.Capacity(math.Round(value))
+			case "restoration":
+				pc.Restoration.Restores = domain.Capacity(math.Round(value))
+			default:
+				return fmt.Errorf("unknown producer field in parameter %s", path)
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: producer of parameter %s", domain.ErrNotFound, path)
+		}
+	case len(parts) == 3 && parts[0] == "strategy":
+		found := setStrategyParam(config.OrderingBots, parts[1], parts[2], value)
+		found = setStrategyParam(config.ProducingBots, parts[1], parts[2], value) || found
+		if !found {
+			return fmt.Errorf("%w: no bot runs the strategy of parameter %s", domain.ErrNotFound, path)
+		}
+	default:
+		return fmt.Errorf("unsupported parameter %s", path)
+	}
+	return nil
+}
+
+func setStrategyParam[K comparable](bots map[K]domain.StrategyConfig, name, param string, value float64) bool {
+	found := false
+	for id, sc := range bots {
+		if sc.Name != name {
+			continue
+		}
+		found = true
+		sc.Params = maps.Clone(sc.Params)
+		if sc.Params == nil {
+			sc.Params = map[string]float64{}
+		}
+		sc.Params[param] = value
+		bots[id] = sc
+	}
+	return found
+}
+
+// clone copies the parts of the configuration the parameters may change
+func clone(config *domain.Configuration) *domain.Configuration {
+	result := *config
+	result.ProducerConfigs = slices.Clone(config.ProducerConfigs)
+	result.OrderingBots = maps.Clone(config.OrderingBots)
+	result.ProducingBots = maps.Clone(config.ProducingBots)
+	return &result
+}
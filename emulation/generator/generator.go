@@ -0,0 +1,295 @@
+package generator
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"emulation/domain"
+)
+
+// Params of a generated economy. The capacities of the producers and the emission are derived
+// from the steady-state demand, so that the economy is feasible with any structure.
+type Params struct {
+	Seed             uint64 `json:"seed"`
+	CapacityTypes    int    `json:"capacityTypes"`
+	ProducersPerType int    `json:"producersPerType"`
+	Products         int    `json:"products"`
+	// Complexity are the weights of the number of capacity types a product requires:
+	// the first weight is of the products requiring a single type, the second of two types and so on
+	Complexity []float64 `json:"complexity"`
+	// Requirement is the range of the capacity a product requires of each of its types
+	Requirement Range `json:"requirement"`
+	// Degradation is the range of the producers' degradation in percent of their capacity per cycle
+	Degradation Range `json:"degradation"`
+	// CrossDependency is the probability of an investment in a product not requiring the producer's own capacity type
+	CrossDependency float64 `json:"crossDependency"`
+	Consumers       int     `json:"consumers"`
+	// ProductsPerConsumer is the number of the products every consumer orders
+	ProductsPerConsumer int `json:"productsPerConsumer"`
+	// Headroom of the producers' capacity over the steady-state demand, at least 1
+	Headroom float64 `json:"headroom"`
+	// Emission is the cycle emission in tokens per unit of the steady-state demand of all the capacity types
+	Emission float64 `json:"emission"`
+	Bots     Bots    `json:"bots,omitzero"`
+}
+
+// Range of integers, both ends included
+type Range struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+func (r Range) random(rnd *rand.Rand) int {
+	return r.Min + rnd.IntN(r.Max-r.Min+1)
+}
+
+// Bots are the strategies of all the generated agents, the agents of a strategy without a name stay idle
+type Bots struct {
+	// Consumers are the ordering bots of the consumers
+	Consumers domain.StrategyConfig `json:"consumers,omitzero"`
+	// Producers are the ordering bots of the producers placing the orders of their investments
+	Producers domain.StrategyConfig `json:"producers,omitzero"`
+	// Investments are the producing bots
+	Investments domain.StrategyConfig `json:"investments,omitzero"`
+}
+
+// the restorations make up for 2 to 4 cycles of degradation, the upgrades add a quarter to a half of the capacity
+const (
+	minRestorationCycles = 2
+	maxRestorationCycles = 4
+	minUpgradeShare      = 0.25
+	maxUpgradeShare      = 0.5
+)
+
+func (p Params) Validate() error {
+	switch {
+	case p.CapacityTypes < 1 || p.ProducersPerType < 1 || p.Products < 1 || p.Consumers < 1 || p.ProductsPerConsumer < 1:
+		return fmt.Errorf("capacity types, producers per type, products, consumers and products per consumer must be positive, got %d, %d, %d, %d and %d",
+			p.CapacityTypes, p.ProducersPerType, p.Products, p.Consumers, p.ProductsPerConsumer)
+	case p.ProductsPerConsumer > p.Products:
+		return fmt.Errorf("products per consumer %d exceed the products %d", p.ProductsPerConsumer, p.Products)
+	case len(p.Complexity) == 0 || len(p.Complexity) > p.CapacityTypes:
+		return fmt.Errorf("complexity must have from 1 to %d weights, got %d", p.CapacityTypes, len(p.Complexity))
+	case slices.ContainsFunc(p.Complexity, func(w float64) bool { return w < 0 }) || lo.Sum(p.Complexity) <= 0:
+		return fmt.Errorf("complexity weights must not be negative and must have a positive sum, got %v", p.Complexity)
+	case p.Requirement.Min < 1 || p.Requirement.Min > p.Requirement.Max:
+		return fmt.Errorf("requirement must be a positive range, got [%d, %d]", p.Requirement.Min, p.Requirement.Max)
+	case p.Degradation.Min < 0 || p.Degradation.Min > p.Degradation.Max || p.Degradation.Max > 100:
+		return fmt.Errorf("degradation must be a range within [0, 100], got [%d, %d]", p.Degradation.Min, p.Degradation.Max)
+	case p.CrossDependency < 0 || p.CrossDependency > 1:
+		return fmt.Errorf("cross dependency must be within [0, 1], got %v", p.CrossDependency)
+	case p.Headroom < 1:
+		return fmt.Errorf("headroom must be at least 1, got %v", p.Headroom)
+	case p.Emission <= 0:
+		return fmt.Errorf("emission must be positive, got %v", p.Emission)
+	}
+	return nil
+}
+
+// Generate builds the economy of the params, the same params give the same configuration.
+// The result passes Configuration.Validate and its analysis has no warnings but the investment cycles,
+// which no economy restoring all its capacity types avoids: some type is restored with a product requiring it.
+func Generate(p Params) (*domain.Configuration, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	g := &generator{p, rand.New(rand.NewPCG(p.Seed, 0)), &domain.Configuration{}}
+	types := g.processSheets()
+	g.producers(types)
+	g.consumers()
+	g.size(types)
+	g.bots()
+
+	analysis := g.config.Analyze()
+	if err := analysis.Err(); err != nil {
+		return nil, fmt.Errorf("generated configuration is invalid: %w", err)
+	}
+	if warnings := lo.Reject(analysis.Warnings, func(f domain.Finding, _ int) bool {
+		return f.Code == domain.FindingInvestmentCycle
+	}); len(warnings) > 0 {
+		return nil, fmt.Errorf("generated configuration is infeasible: %s",
+			strings.Join(lo.Map(warnings, func(f domain.Finding, _ int) string { return f.Message }), "; "))
+	}
+	return g.config, nil
+}
+
+type generator struct {
+	params Params
+	rnd    *rand.Rand
+	config *domain.Configuration
+}
+
+// processSheets requires the capacity types of the products by the complexity weights,
+// the types no product has drawn are added to random products
+func (g *generator) processSheets() []domain.CapacityType {
+	types := make([]domain.CapacityType, g.params.CapacityTypes)
+	for i := range types {
+		types[i] = domain.CapacityType(fmt.Sprintf("capacity-%d", i+1))
+	}
+	required := map[domain.CapacityType]bool{}
+	for i := range g.params.Products {
+		sheet := domain.ProcessSheet{Product: domain.Product(i + 1), Require: map[domain.CapacityType]domain.Capacity{}}
+		for _, t := range g.rnd.Perm(len(types))[:g.complexity()] {
+			sheet.Require[types[t]] = domain.Capacity(g.params.Requirement.random(g.rnd))
+			required[types[t]] = true
+		}
+		g.config.ProcessSheets = append(g.config.ProcessSheets, sheet)
+	}
+	for _, t := range types {
+		if !required[t] {
+			sheet := g.config.ProcessSheets[g.rnd.IntN(len(g.config.ProcessSheets))]
+			sheet.Require[t] = domain.Capacity(g.params.Requirement.random(g.rnd))
+		}
+	}
+	return types
+}
+
+// complexity draws the number of the capacity types of a product
+func (g *generator) complexity() int {
+	x := g.rnd.Float64() * lo.Sum(g.params.Complexity)
+	for i, w := range g.params.Complexity {
+		if x < w {
+			return i + 1
+		}
+		x -= w
+	}
+	return len(g.params.Complexity)
+}
+
+// producers draws the degradation and the investment products, the capacities are sized later
+func (g *generator) producers(types []domain.CapacityType) {
+	for _, t := range types {
+		// the products not requiring the producer's type are its cross-dependencies
+		cross := lo.Filter(g.config.ProcessSheets, func(sheet domain.ProcessSheet, _ int) bool {
+			_, ok := sheet.Require[t]
+			return !ok
+		})
+		investment := func() domain.Product {
+			if len(cross) > 0 && g.rnd.Float64() < g.params.CrossDependency {
+				return cross[g.rnd.IntN(len(cross))].Product
+			}
+			return g.config.ProcessSheets[g.rnd.IntN(len(g.config.ProcessSheets))].Product
+		}
+		for range g.params.ProducersPerType {
+			g.config.ProducerConfigs = append(g.config.ProducerConfigs, domain.ProducingAgentConfig{
+				Id:          domain.ProducerId(fmt.Sprintf("p%d", len(g.config.ProducerConfigs)+1)),
+				Type:        t,
+				Degradation: domain.DegradationRate(g.params.Degradation.random(g.rnd)),
+				Restoration: domain.Restoration{Require: investment()},
+				Upgrade:     domain.Upgrade{Require: investment()},
+			})
+		}
+	}
+}
+
+// consumers draws the products of the consumers, the products neither ordered nor invested in
+// are added to the consumers in turn
+func (g *generator) consumers() {
+	ordered := map[domain.Product]bool{}
+	for _, producer := range g.config.ProducerConfigs {
+		ordered[producer.Restoration.Require] = true
+		ordered[producer.Upgrade.Require] = true
+	}
+	for i := range g.params.Consumers {
+		consumer := domain.ConsumerConfig{Id: domain.ConsumerId(fmt.Sprintf("c%d", i+1))}
+		for _, p := range g.rnd.Perm(g.params.Products)[:g.params.ProductsPerConsumer] {
+			consumer.Products = append(consumer.Products, domain.Product(p+1))
+			ordered[domain.Product(p+1)] = true
+		}
+		g.config.Consumers = append(g.config.Consumers, consumer)
+	}
+	next := 0
+	for _, sheet := range g.config.ProcessSheets {
+		if !ordered[sheet.Product] {
+			consumer := &g.config.Consumers[next%len(g.config.Consumers)]
+			consumer.Products = append(consumer.Products, sheet.Product)
+			next++
+		}
+	}
+	for i := range g.config.Consumers {
+		slices.Sort(g.config.Consumers[i].Products)
+	}
+}
+
+// size derives the capacities from the steady-state demand, estimated as Configuration.Analyze does:
+// the consumers order one of their products a cycle and the restorations make up for the degradation.
+// A producer can serve the largest requirement of its type alone. The emission is paid for the demand.
+func (g *generator) size(types []domain.CapacityType) {
+	sheets := lo.SliceToMap(g.config.ProcessSheets, func(sheet domain.ProcessSheet) (domain.Product, domain.ProcessSheet) {
+		return sheet.Product, sheet
+	})
+	demand := map[domain.CapacityType]float64{}
+	for _, consumer := range g.config.Consumers {
+		for _, product := range consumer.Products {
+			for t, capacity := range sheets[product].Require {
+				demand[t] += float64(capacity) / float64(len(consumer.Products))
+			}
+		}
+	}
+	// the restorations of a producer make up for restorationCycles cycles of degradation,
+	// so it restores 1/restorationCycles times a cycle at most
+	restorationCycles := make([]float64, len(g.config.ProducerConfigs))
+	for i, producer := range g.config.ProducerConfigs {
+		restorationCycles[i] = minRestorationCycles + g.rnd.Float64()*(maxRestorationCycles-minRestorationCycles)
+		if producer.Degradation == 0 {
+			continue
+		}
+		for t, capacity := range sheets[producer.Restoration.Require].Require {
+			demand[t] += float64(capacity) / restorationCycles[i]
+		}
+	}
+
+	capacities := map[domain.CapacityType]domain.Capacity{}
+	total := 0.0
+	for _, t := range types {
+		largest := lo.Max(lo.Map(g.config.ProcessSheets, func(sheet domain.ProcessSheet, _ int) domain.Capacity {
+			return sheet.Require[t]
+		}))
+		supply := math.Ceil(g.params.Headroom * demand[t] / float64(g.params.ProducersPerType))
+		capacities[t] = max(domain.Capacity(supply), largest)
+		total += demand[t]
+	}
+	for i := range g.config.ProducerConfigs {
+		producer := &g.config.ProducerConfigs[i]
+		producer.Capacity = capacities[producer.Type]
+		degradation := math.Ceil(float64(producer.Degradation) * float64(producer.Capacity) / 100)
+		producer.Restoration.Restores = max(1, domain.Capacity(math.Ceil(degradation*restorationCycles[i])))
+		share := minUpgradeShare + g.rnd.Float64()*(maxUpgradeShare-minUpgradeShare)
+		producer.Upgrade.Increases = max(1, domain.Capacity(math.Ceil(share*float64(producer.Capacity))))
+	}
+	g.config.CycleEmission = domain.Tokens(math.Ceil(g.params.Emission * total))
+}
+
+// bots assigns the strategies to all the agents, every agent gets own params
+func (g *generator) bots() {
+	bots := g.params.Bots
+	clone := func(sc domain.StrategyConfig) domain.StrategyConfig {
+		return domain.StrategyConfig{Name: sc.Name, Params: maps.Clone(sc.Params)}
+	}
+	if bots.Consumers.Name != "" {
+		g.config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{}
+		for _, consumer := range g.config.Consumers {
+			g.config.OrderingBots[domain.OrderingAgentId(consumer.Id)] = clone(bots.Consumers)
+		}
+	}
+	if bots.Producers.Name != "" {
+		if g.config.OrderingBots == nil {
+			g.config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{}
+		}
+		for _, producer := range g.config.ProducerConfigs {
+			g.config.OrderingBots[domain.OrderingAgentId(producer.Id)] = clone(bots.Producers)
+		}
+	}
+	if bots.Investments.Name != "" {
+		g.config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{}
+		for _, producer := range g.config.ProducerConfigs {
+			g.config.ProducingBots[producer.Id] = clone(bots.Investments)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"emulation/domain"
+	"emulation/simulation"
+	"emulation/strategy"
+)
+
+func testParams() Params {
+	return Params{
+		Seed:                1,
+		CapacityTypes:       4,
+		ProducersPerType:    2,
+		Products:            6,
+		Complexity:          []float64{0.3, 0.5, 0.2},
+		Requirement:         Range{Min: 5, Max: 60},
+		Degradation:         Range{Min: 2, Max: 10},
+		CrossDependency:     0.7,
+		Consumers:           3,
+		ProductsPerConsumer: 3,
+		Headroom:            1.5,
+		Emission:            3,
+		Bots: Bots{
+			Consumers:   domain.StrategyConfig{Name: strategy.ProportionalToCapacityName},
+			Producers:   domain.StrategyConfig{Name: strategy.ProportionalToPriceName},
+			Investments: domain.StrategyConfig{Name: strategy.ThresholdName, Params: map[string]float64{"restoreBelow": 0.8}},
+		},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run(`Given the params with many seeds and structures
+		When the economies are generated
+		Then every economy is valid and has no warnings but the investment cycles`, func(t *testing.T) {
+
+		for _, variant := range []func(p *Params){
+			func(p *Params) {},
+			func(p *Params) {
+				p.CapacityTypes, p.Products, p.Complexity, p.ProductsPerConsumer = 1, 1, []float64{1}, 1
+			},
+			func(p *Params) { p.CapacityTypes, p.Products, p.ProductsPerConsumer = 8, 2, 1 },
+			func(p *Params) { p.CrossDependency, p.Degradation = 0, Range{Min: 0, Max: 0} },
+			func(p *Params) { p.Degradation, p.Headroom = Range{Min: 50, Max: 100}, 1 },
+		} {
+			for seed := range uint64(50) {
+				params := testParams()
+				variant(&params)
+				params.Seed = seed
+				config, err := Generate(params)
+				require.NoError(t, err, "seed %d", seed)
+				require.NoError(t, config.Validate())
+				require.Len(t, config.ProducerConfigs, params.CapacityTypes*params.ProducersPerType)
+				require.Len(t, config.ProcessSheets, params.Products)
+				require.Len(t, config.Consumers, params.Consumers)
+			}
+		}
+	})
+
+	t.Run(`Given the same params
+		When the economy is generated twice
+		Then it is the same, and another seed gives another economy`, func(t *testing.T) {
+
+		first, err := Generate(testParams())
+		require.NoError(t, err)
+		second, err := Generate(testParams())
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(first, second))
+
+		params := testParams()
+		params.Seed = 2
+		other, err := Generate(params)
+		require.NoError(t, err)
+		require.NotEmpty(t, cmp.Diff(first, other))
+	})
+
+	t.Run(`Given the params with bots
+		When the economy is generated and played
+		Then every agent is driven by its own copy of the strategy`, func(t *testing.T) {
+
+		config, err := Generate(testParams())
+		require.NoError(t, err)
+		require.Len(t, config.OrderingBots, 3+8)
+		require.Len(t, config.ProducingBots, 8)
+		config.ProducingBots["p1"].Params["restoreBelow"] = 0.5
+		require.Equal(t, 0.8, config.ProducingBots["p2"].Params["restoreBelow"])
+
+		result, err := simulation.Run(config, simulation.Options{Cycles: 20, Seed: 1})
+		require.NoError(t, err)
+		require.Len(t, result.Cycles, 20)
+		require.Positive(t, lo.SumBy(result.Cycles, func(c simulation.CycleRecord) domain.Capacity { return c.AcceptedCapacity }))
+	})
+
+	t.Run(`Given invalid params
+		When the economy is generated
+		Then an error is returned`, func(t *testing.T) {
+
+		for _, c := range []struct {
+			variant func(p *Params)
+			err     string
+		}{
+			{func(p *Params) { p.ProducersPerType = 0 }, "must be positive"},
+			{func(p *Params) { p.ProductsPerConsumer = 7 }, "products per consumer 7 exceed the products 6"},
+			{func(p *Params) { p.Complexity = []float64{1, 1, 1, 1, 1} }, "complexity must have from 1 to 4 weights"},
+			{func(p *Params) { p.Complexity = []float64{0, 0} }, "positive sum"},
+			{func(p *Params) { p.Requirement = Range{Min: 10, Max: 5} }, "requirement must be a positive range"},
+			{func(p *Params) { p.Degradation = Range{Min: 0, Max: 101} }, "degradation must be a range"},
+			{func(p *Params) { p.CrossDependency = 2 }, "cross dependency"},
+			{func(p *Params) { p.Headroom = 0.5 }, "headroom"},
+			{func(p *Params) { p.Emission = 0 }, "emission"},
+		} {
+			params := testParams()
+			c.variant(&params)
+			_, err := Generate(params)
+			require.ErrorContains(t, err, c.err)
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package gym
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"emulation/domain"
+	"emulation/strategy"
+)
+
+var ErrNotReset = errors.New("environment is not reset")
+var ErrDone = errors.New("episode is done")
+
+// Phase is the part of a cycle in which the agents act
+type Phase string
+
+const (
+	// PhaseInvestment is the orders placement state, the producing agents act
+	PhaseInvestment Phase = "investment"
+	// PhaseOrdering is the ordering state, the ordering agents act
+	PhaseOrdering Phase = "ordering"
+)
+
+// Observation holds the views of the agents acting in the current phase.
+// Bot-controlled agents are driven by the environment and are not observed.
+type Observation struct {
+	Cycle     uint
+	Phase     Phase
+	Ordering  map[domain.OrderingAgentId]domain.OrderingAgentView
+	Producing map[domain.ProducerId]domain.ProducingAgentView
+}
+
+// Actions holds the commands of the agents acting in the current phase.
+// An agent without a command stays idle.
+type Actions struct {
+	Ordering  map[domain.OrderingAgentId]domain.OrderingAgentCommand
+	Producing map[domain.ProducerId]domain.ProducingAgentCommand
+}
+
+// Rewards are given when a cycle completes, so they are zero after an investment step.
+// An ordering agent gets the negated score of its orders,
+// a producing agent gets the tokens its auction collected in the cycle.
+type Rewards struct {
+	Ordering  map[domain.OrderingAgentId]float64
+	Producing map[domain.ProducerId]float64
+}
+
+type Step struct {
+	Observation Observation
+	Rewards     Rewards
+	// Score of the completed cycle, zero after an investment step
+	Score domain.Score
+	// Rejected holds the errors of the commands refused by the system by agent id, such agents stay idle
+	Rejected map[string]string
+	Done     bool
+}
+
+// Env is a step-oriented environment for the learning agents: every step plays a single phase of a cycle
+// with the actions of all agents acting in it, an episode lasts the given number of cycles.
+// The agents marked as bots in the configuration are driven by their strategies, a bot refused by the system
+// is logged and stays idle.
+type Env struct {
+	config *domain.Configuration
+	cycles uint
+	bots   *strategy.Bots
+	idGen  domain.SequentialIdGenerator
+	system *domain.System
+	events *domain.EventBus
+	// start is the cycle counter of the reset system
+	start uint
+	done  bool
+}
+
+// NewEnv creates the environment, the domain events of the episodes are published on the events bus, which may be nil
+func NewEnv(config *domain.Configuration, cycles uint, events *domain.EventBus) (*Env, error) {
+	if cycles == 0 {
+		return nil, errors.New("episode must last at least one cycle")
+	}
+	bots, err := strategy.NewBots(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Env{config: config, cycles: cycles, bots: bots, events: events}, nil
+}
+
+// Reset starts a new episode, the seed drives the consumers' demand
+func (e *Env) Reset(seed uint64) (Observation, error) {
+	e.idGen = 0
+	e.system = domain.NewSystem(&e.idGen, e.config, domain.NewConsumers(e.config.Consumers, seed), e.events)
+	e.start = e.system.GetSystemInfo().CycleCounter
+	e.done = false
+	return e.observe()
+}
+
+// Step applies the actions and plays the current phase. An action of an agent not acting in the phase
+// is an error, nothing is played then.
+func (e *Env) Step(actions Actions) (Step, error) {
+	if e.system == nil {
+		return Step{}, ErrNotReset
+	}
+	if e.done {
+		return Step{}, ErrDone
+	}
+	if e.system.GetSystemInfo().State == domain.SystemStateOrdersPlacement {
+		return e.invest(actions)
+	}
+	return e.order(actions)
+}
+
+func (e *Env) invest(actions Actions) (Step, error) {
+	if len(actions.Ordering) > 0 {
+		return Step{}, fmt.Errorf("%w: ordering actions in the investment phase", domain.ErrWrongState)
+	}
+	producers := e.producers()
+	for id := range actions.Producing {
+		if !slices.Contains(producers, id) {
+			return Step{}, fmt.Errorf("%w: producing agent [%s] is not controlled by the environment", domain.ErrNotFound, id)
+		}
+	}
+
+	rejected := map[string]string{}
+	for _, id := range slices.Sorted(maps.Keys(actions.Producing)) {
+		if err := e.system.ProducingAgentAction(id, actions.Producing[id]); err != nil {
+			rejected[string(id)] = err.Error()
+		}
+	}
+	if err := e.bots.Invest(e.system); err != nil {
+		slog.Error("gym.invest.bots_failed",
+			slog.String("error", err.Error()))
+	}
+	if err := e.system.StartOrdering(); err != nil {
+		return Step{}, err
+	}
+
+	observation, err := e.observe()
+	if err != nil {
+		return Step{}, err
+	}
+	return Step{
+		Observation: observation,
+		Rewards:     e.rewards(nil, 0),
+		Rejected:    rejected,
+	}, nil
+}
+
+func (e *Env) order(actions Actions) (Step, error) {
+	if len(actions.Producing) > 0 {
+		return Step{}, fmt.Errorf("%w: producing actions in the ordering phase", domain.ErrWrongState)
+	}
+	agents := e.orderingAgents()
+	for id := range actions.Ordering {
+		if !slices.Contains(agents, id) {
+			return Step{}, fmt.Errorf("%w: ordering agent [%s] is not controlled by the environment", domain.ErrNotFound, id)
+		}
+	}
+
+	rejected := map[string]string{}
+	for _, id := range slices.Sorted(maps.Keys(actions.Ordering)) {
+		if err := e.system.OrderingAgentAction(id, actions.Ordering[id]); err != nil {
+			rejected[string(id)] = err.Error()
+		}
+	}
+	if err := e.bots.Order(e.system); err != nil {
+		slog.Error("gym.order.bots_failed",
+			slog.String("error", err.Error()))
+	}
+	cycle := e.system.GetSystemInfo().CycleCounter
+	result, err := e.system.CompleteCycle()
+	if err != nil {
+		return Step{}, err
+	}
+	e.done = e.system.GetSystemInfo().CycleCounter-e.start >= e.cycles
+
+	observation, err := e.observe()
+	if err != nil {
+		return Step{}, err
+	}
+	return Step{
+		Observation: observation,
+		Rewards:     e.rewards(&result, cycle),
+		Score:       result.Score,
+		Rejected:    rejected,
+		Done:        e.done,
+	}, nil
+}
+
+func (e *Env) observe() (Observation, error) {
+	info := e.system.GetSystemInfo()
+	if info.State == domain.SystemStateOrdersPlacement {
+		views := make(map[domain.ProducerId]domain.ProducingAgentView)
+		for _, id := range e.producers() {
+			view, err := e.system.ProducingAgentView(id)
+			if err != nil {
+				return Observation{}, err
+			}
+			views[id] = view
+		}
+		return Observation{Cycle: info.CycleCounter, Phase: PhaseInvestment, Producing: views}, nil
+	}
+	views := make(map[domain.OrderingAgentId]domain.OrderingAgentView)
+	for _, id := range e.orderingAgents() {
+		view, err := e.system.OrderingAgentView(id)
+		if err != nil {
+			return Observation{}, err
+		}
+		views[id] = view
+	}
+	return Observation{Cycle: info.CycleCounter, Phase: PhaseOrdering, Ordering: views}, nil
+}
+
+// rewards of every controlled agent, the result is nil when no cycle is completed
+func (e *Env) rewards(result *domain.CycleResult, cycle uint) Rewards {
+	rewards := Rewards{
+		lo.SliceToMap(e.orderingAgents(), func(id domain.OrderingAgentId) (domain.OrderingAgentId, float64) {
+			if result == nil {
+				return id, 0
+			}
+			return id, -float64(result.AgentScores[id])
+		}),
+		lo.SliceToMap(e.producers(), func(id domain.ProducerId) (domain.ProducerId, float64) {
+			return id, 0
+		}),
+	}
+	if result == nil {
+		return rewards
+	}
+	for _, r := range e.system.MarketHistory(cycle, cycle) {
+		if _, ok := rewards.Producing[r.ProducerId]; ok {
+			rewards.Producing[r.ProducerId] += float64(r.Funds)
+		}
+	}
+	return rewards
+}
+
+// producers lists the producing agents controlled by the environment
+func (e *Env) producers() []domain.ProducerId {
+	var result []domain.ProducerId
+	for _, id := range slices.Sorted(maps.Keys(e.system.GetProducerInfos())) {
+		if _, ok := e.config.ProducingBots[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// orderingAgents lists the ordering agents controlled by the environment
+func (e *Env) orderingAgents() []domain.OrderingAgentId {
+	var result []domain.OrderingAgentId
+	for _, id := range slices.Sorted(maps.Keys(e.system.GetOrderingAgentInfos())) {
+		if _, ok := e.config.OrderingBots[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,166 @@
+package gym
+
+import (
+	"testing"
+
+	"emulation/domain"
+	"emulation/domain/domaintest"
+	"emulation/strategy"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig is the shared economy with a scarce second capacity type and a single bot restoring it
+func testConfig() *domain.Configuration {
+	config := domaintest.Economy()
+	config.ProducerConfigs[1].Capacity = 50
+	config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{
+		"p2": {Name: strategy.RestoreBelowName},
+	}
+	return config
+}
+
+// play runs an episode with every controlled agent driven by the strategies
+func play(t *testing.T, env *Env, seed uint64) []Step {
+	observation, err := env.Reset(seed)
+	require.NoError(t, err)
+	producing, err := strategy.NewProducingStrategy(domain.StrategyConfig{Name: strategy.ThresholdName})
+	require.NoError(t, err)
+	var steps []Step
+	for {
+		actions := Actions{
+			Ordering: lo.MapValues(observation.Ordering, func(view domain.OrderingAgentView, _ domain.OrderingAgentId) domain.OrderingAgentCommand {
+				return strategy.ProportionalToCapacity{}.Command(view)
+			}),
+			Producing: lo.MapValues(observation.Producing, func(view domain.ProducingAgentView, _ domain.ProducerId) domain.ProducingAgentCommand {
+				return producing.Command(view)
+			}),
+		}
+		step, err := env.Step(actions)
+		require.NoError(t, err)
+		require.Empty(t, step.Rejected)
+		steps = append(steps, step)
+		if step.Done {
+			return steps
+		}
+		observation = step.Observation
+	}
+}
+
+func TestEnv(t *testing.T) {
+	t.Run(`Given a reset environment
+		Then the controlled producing agents are observed in the investment phase
+		And the bot-controlled ones are not`, func(t *testing.T) {
+
+		env, err := NewEnv(testConfig(), 3, nil)
+		require.NoError(t, err)
+		observation, err := env.Reset(1)
+		require.NoError(t, err)
+		require.Equal(t, PhaseInvestment, observation.Phase)
+		require.Equal(t, uint(1), observation.Cycle)
+		require.Equal(t, []domain.ProducerId{"p1"}, lo.Keys(observation.Producing))
+		require.Empty(t, observation.Ordering)
+
+		step, err := env.Step(Actions{})
+		require.NoError(t, err)
+		require.Equal(t, PhaseOrdering, step.Observation.Phase)
+		require.ElementsMatch(t, []domain.OrderingAgentId{"c1", "c2", "p1", "p2"}, lo.Keys(step.Observation.Ordering))
+		require.Equal(t, map[domain.ProducerId]float64{"p1": 0}, step.Rewards.Producing)
+		require.False(t, step.Done)
+	})
+
+	t.Run(`Given an episode of 3 cycles
+		When it is played
+		Then it is done after 3 ordering steps
+		And the ordering rewards sum up to the negated score
+		And the same seed gives the same episode`, func(t *testing.T) {
+
+		env, err := NewEnv(testConfig(), 3, nil)
+		require.NoError(t, err)
+		steps := play(t, env, 1)
+		require.Len(t, steps, 6)
+		for i, step := range steps {
+			require.Equal(t, i == 5, step.Done)
+			total := lo.Sum(lo.Values(step.Rewards.Ordering))
+			require.Equal(t, -float64(step.Score), total)
+		}
+		require.Positive(t, lo.SumBy(steps, func(s Step) domain.Score { return s.Score }))
+		require.Positive(t, lo.SumBy(steps, func(s Step) float64 { return s.Rewards.Producing["p1"] }))
+
+		_, err = env.Step(Actions{})
+		require.ErrorIs(t, err, ErrDone)
+
+		require.True(t, cmp.Equal(steps, play(t, env, 1), cmpopts.EquateNaNs()))
+	})
+
+	t.Run(`Given an action of an agent not acting in the phase
+		When a step is made
+		Then an error is returned
+		And the phase is not played`, func(t *testing.T) {
+
+		env, err := NewEnv(testConfig(), 3, nil)
+		require.NoError(t, err)
+		_, err = env.Step(Actions{})
+		require.ErrorIs(t, err, ErrNotReset)
+
+		_, err = env.Reset(1)
+		require.NoError(t, err)
+		_, err = env.Step(Actions{Producing: map[domain.ProducerId]domain.ProducingAgentCommand{"p2": {}}})
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = env.Step(Actions{Ordering: map[domain.OrderingAgentId]domain.OrderingAgentCommand{"c1": {}}})
+		require.ErrorIs(t, err, domain.ErrWrongState)
+
+		step, err := env.Step(Actions{})
+		require.NoError(t, err)
+		require.Equal(t, PhaseOrdering, step.Observation.Phase)
+	})
+
+	t.Run(`Given an invalid command
+		When a step is made
+		Then the command is rejected
+		And the agent stays idle`, func(t *testing.T) {
+
+		env, err := NewEnv(testConfig(), 3, nil)
+		require.NoError(t, err)
+		_, err = env.Reset(1)
+		require.NoError(t, err)
+		step, err := env.Step(Actions{})
+		require.NoError(t, err)
+
+		step, err = env.Step(Actions{Ordering: map[domain.OrderingAgentId]domain.OrderingAgentCommand{"c1": {}}})
+		require.NoError(t, err)
+		require.Contains(t, step.Rejected, "c1")
+		require.Equal(t, PhaseInvestment, step.Observation.Phase)
+	})
+	t.Run(`Given a bot whose investment is refused by the system
+		When the agents act in the investment phase
+		Then the step still plays the phase with the agents' actions
+		And the episode goes on`, func(t *testing.T) {
+
+		config := testConfig()
+		// the restoration product of the bot has no process sheet, so its restoration is refused once the capacity degrades
+		config.ProducerConfigs[1].Restoration.Require = 99
+		config.ProducingBots["p2"] = domain.StrategyConfig{Name: strategy.RestoreBelowName, Params: map[string]float64{"below": 1}}
+		env, err := NewEnv(config, 3, nil)
+		require.NoError(t, err)
+		_, err = env.Reset(1)
+		require.NoError(t, err)
+		_, err = env.Step(Actions{})
+		require.NoError(t, err)
+		_, err = env.Step(Actions{})
+		require.NoError(t, err)
+
+		step, err := env.Step(Actions{Producing: map[domain.ProducerId]domain.ProducingAgentCommand{"p1": {DoRestoration: true}}})
+		require.NoError(t, err)
+		require.Empty(t, step.Rejected)
+		require.Equal(t, PhaseOrdering, step.Observation.Phase)
+
+		step, err = env.Step(Actions{})
+		require.NoError(t, err)
+		require.Equal(t, PhaseInvestment, step.Observation.Phase)
+		require.Equal(t, domain.RestorationRunning(true), step.Observation.Producing["p1"].RestorationRunning)
+	})
+}
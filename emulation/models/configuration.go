@@ -6,7 +6,9 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"strconv"
 
 	"github.com/go-openapi/errors"
@@ -20,10 +22,19 @@ import (
 // swagger:model Configuration
 type Configuration struct {
 
+	// Final consumers randomly ordering the listed products
+	Consumers []*ConsumerConfig `json:"consumers,omitempty"`
+
 	// Amount of tokens emitted each cycle
 	// Required: true
 	CycleEmission *int64 `json:"cycleEmission"`
 
+	// deadlines
+	Deadlines *Deadlines `json:"deadlines,omitempty"`
+
+	// Ordering agents controlled by built-in strategies
+	OrderingBots []*OrderingBotConfig `json:"orderingBots,omitempty"`
+
 	// process sheets
 	// Required: true
 	ProcessSheets []*ProcessSheet `json:"processSheets"`
@@ -31,16 +42,83 @@ type Configuration struct {
 	// producer configs
 	// Required: true
 	ProducerConfigs []*ProducingAgentConfig `json:"producerConfigs"`
+
+	// Producing agents controlled by built-in strategies
+	ProducingBots []*ProducingBotConfig `json:"producingBots,omitempty"`
+
+	// Schema version of the configuration, the current one when absent. The API takes the current version only, the older files are migrated by the server on loading.
+	Version int64 `json:"version,omitempty"`
+}
+
+// UnmarshalJSON unmarshals this object while disallowing additional properties from JSON
+func (m *Configuration) UnmarshalJSON(data []byte) error {
+	var props struct {
+
+		// Final consumers randomly ordering the listed products
+		Consumers []*ConsumerConfig `json:"consumers,omitempty"`
+
+		// Amount of tokens emitted each cycle
+		// Required: true
+		CycleEmission *int64 `json:"cycleEmission"`
+
+		// deadlines
+		Deadlines *Deadlines `json:"deadlines,omitempty"`
+
+		// Ordering agents controlled by built-in strategies
+		OrderingBots []*OrderingBotConfig `json:"orderingBots,omitempty"`
+
+		// process sheets
+		// Required: true
+		ProcessSheets []*ProcessSheet `json:"processSheets"`
+
+		// producer configs
+		// Required: true
+		ProducerConfigs []*ProducingAgentConfig `json:"producerConfigs"`
+
+		// Producing agents controlled by built-in strategies
+		ProducingBots []*ProducingBotConfig `json:"producingBots,omitempty"`
+
+		// Schema version of the configuration, the current one when absent. The API takes the current version only, the older files are migrated by the server on loading.
+		Version int64 `json:"version,omitempty"`
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&props); err != nil {
+		return err
+	}
+
+	m.Consumers = props.Consumers
+	m.CycleEmission = props.CycleEmission
+	m.Deadlines = props.Deadlines
+	m.OrderingBots = props.OrderingBots
+	m.ProcessSheets = props.ProcessSheets
+	m.ProducerConfigs = props.ProducerConfigs
+	m.ProducingBots = props.ProducingBots
+	m.Version = props.Version
+	return nil
 }
 
 // Validate validates this configuration
 func (m *Configuration) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConsumers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCycleEmission(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateDeadlines(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOrderingBots(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProcessSheets(formats); err != nil {
 		res = append(res, err)
 	}
@@ -49,12 +127,42 @@ func (m *Configuration) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateProducingBots(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Configuration) validateConsumers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Consumers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Consumers); i++ {
+		if swag.IsZero(m.Consumers[i]) { // not required
+			continue
+		}
+
+		if m.Consumers[i] != nil {
+			if err := m.Consumers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("consumers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("consumers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Configuration) validateCycleEmission(formats strfmt.Registry) error {
 
 	if err := validate.Required("cycleEmission", "body", m.CycleEmission); err != nil {
@@ -64,6 +172,51 @@ func (m *Configuration) validateCycleEmission(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Configuration) validateDeadlines(formats strfmt.Registry) error {
+	if swag.IsZero(m.Deadlines) { // not required
+		return nil
+	}
+
+	if m.Deadlines != nil {
+		if err := m.Deadlines.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("deadlines")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("deadlines")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Configuration) validateOrderingBots(formats strfmt.Registry) error {
+	if swag.IsZero(m.OrderingBots) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.OrderingBots); i++ {
+		if swag.IsZero(m.OrderingBots[i]) { // not required
+			continue
+		}
+
+		if m.OrderingBots[i] != nil {
+			if err := m.OrderingBots[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("orderingBots" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("orderingBots" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Configuration) validateProcessSheets(formats strfmt.Registry) error {
 
 	if err := validate.Required("processSheets", "body", m.ProcessSheets); err != nil {
@@ -118,10 +271,48 @@ func (m *Configuration) validateProducerConfigs(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Configuration) validateProducingBots(formats strfmt.Registry) error {
+	if swag.IsZero(m.ProducingBots) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ProducingBots); i++ {
+		if swag.IsZero(m.ProducingBots[i]) { // not required
+			continue
+		}
+
+		if m.ProducingBots[i] != nil {
+			if err := m.ProducingBots[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("producingBots" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("producingBots" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this configuration based on the context it is used
 func (m *Configuration) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateConsumers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDeadlines(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOrderingBots(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProcessSheets(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -130,12 +321,87 @@ func (m *Configuration) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateProducingBots(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Configuration) contextValidateConsumers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Consumers); i++ {
+
+		if m.Consumers[i] != nil {
+
+			if swag.IsZero(m.Consumers[i]) { // not required
+				return nil
+			}
+
+			if err := m.Consumers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("consumers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("consumers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Configuration) contextValidateDeadlines(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Deadlines != nil {
+
+		if swag.IsZero(m.Deadlines) { // not required
+			return nil
+		}
+
+		if err := m.Deadlines.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("deadlines")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("deadlines")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Configuration) contextValidateOrderingBots(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.OrderingBots); i++ {
+
+		if m.OrderingBots[i] != nil {
+
+			if swag.IsZero(m.OrderingBots[i]) { // not required
+				return nil
+			}
+
+			if err := m.OrderingBots[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("orderingBots" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("orderingBots" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Configuration) contextValidateProcessSheets(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.ProcessSheets); i++ {
@@ -186,6 +452,31 @@ func (m *Configuration) contextValidateProducerConfigs(ctx context.Context, form
 	return nil
 }
 
+func (m *Configuration) contextValidateProducingBots(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ProducingBots); i++ {
+
+		if m.ProducingBots[i] != nil {
+
+			if swag.IsZero(m.ProducingBots[i]) { // not required
+				return nil
+			}
+
+			if err := m.ProducingBots[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("producingBots" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("producingBots" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Configuration) MarshalBinary() ([]byte, error) {
 	if m == nil {
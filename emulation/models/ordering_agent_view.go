@@ -15,7 +15,7 @@ import (
 )
 
 // OrderingAgentView Ordering agent view
-// Example: {"incoming":{"order-1":{"capacity-1":110,"capacity-2":350},"order-2":{"capacity-3":55}},"producers":{"capacity-1":{"producer-1":{"capacity":120,"degradation":5,"id":"producer-1","maxCapacity":150,"requestedCapacity":500,"restoration":20,"restorationRunning":"false","upgrade":10,"upgradeRunning":"true"}}}}
+// Example: {"incoming":{"order-1":{"capacity-1":110,"capacity-2":350},"order-2":{"capacity-3":55}},"producers":{"capacity-1":{"producer-1":{"capacity":120,"degradation":5,"id":"producer-1","maxCapacity":150,"requestedCapacity":500,"restoration":20,"restorationRunning":"false","upgrade":10,"upgradeRunning":"true"}}},"tokens":{"order-1":400,"order-2":60}}
 //
 // swagger:model OrderingAgentView
 type OrderingAgentView struct {
@@ -25,6 +25,9 @@ type OrderingAgentView struct {
 
 	// producers
 	Producers map[string]map[string]ProducingAgentInfo `json:"producers,omitempty"`
+
+	// tokens
+	Tokens map[string]int64 `json:"tokens,omitempty"`
 }
 
 // Validate validates this ordering agent view
@@ -0,0 +1,32 @@
+package models
+
+import "slices"
+
+// Principal is the client authenticated by an API key
+type Principal struct {
+	Name           string
+	Admin          bool
+	OrderingAgents []string
+	Producers      []string
+}
+
+// CanOrder tells whether the principal may command the ordering agent
+func (p *Principal) CanOrder(agentId string) bool {
+	return p.Admin || slices.Contains(p.OrderingAgents, agentId)
+}
+
+// CanProduce tells whether the principal may command the producing agent
+func (p *Principal) CanProduce(producerId string) bool {
+	return p.Admin || slices.Contains(p.Producers, producerId)
+}
+
+// CanWatch tells whether the principal may watch the events of the agents, all agents if none is given.
+// Only an admin watches all agents, the others watch the agents they command.
+func (p *Principal) CanWatch(agentIds []string) bool {
+	if p.Admin {
+		return true
+	}
+	return len(agentIds) > 0 && !slices.ContainsFunc(agentIds, func(id string) bool {
+		return !p.CanOrder(id) && !p.CanProduce(id)
+	})
+}
@@ -6,7 +6,9 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -28,6 +30,30 @@ type ProcessSheet struct {
 	Require map[string]int64 `json:"require"`
 }
 
+// UnmarshalJSON unmarshals this object while disallowing additional properties from JSON
+func (m *ProcessSheet) UnmarshalJSON(data []byte) error {
+	var props struct {
+
+		// Product identifier
+		// Required: true
+		Product *int64 `json:"product"`
+
+		// Map of capacity type to required capacity
+		// Required: true
+		Require map[string]int64 `json:"require"`
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&props); err != nil {
+		return err
+	}
+
+	m.Product = props.Product
+	m.Require = props.Require
+	return nil
+}
+
 // Validate validates this process sheet
 func (m *ProcessSheet) Validate(formats strfmt.Registry) error {
 	var res []error
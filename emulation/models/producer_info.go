@@ -23,8 +23,8 @@ type ProducerInfo struct {
 	// Capacity type
 	CapacityType int64 `json:"CapacityType,omitempty"`
 
-	// The cut off price in the previous cycle
-	CutOffPrice int64 `json:"CutOffPrice,omitempty"`
+	// The cut off price in the previous cycle. Absent before the first accepted bid
+	CutOffPrice *float64 `json:"CutOffPrice,omitempty"`
 
 	// Producer agent ID
 	ID string `json:"Id,omitempty"`
@@ -6,7 +6,9 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -32,7 +34,7 @@ type ProducingAgentConfig struct {
 	ID *string `json:"id"`
 
 	// restoration
-	Restoration Restoration `json:"restoration,omitempty"`
+	Restoration *Restoration `json:"restoration,omitempty"`
 
 	// Capacity type
 	// Required: true
@@ -42,6 +44,48 @@ type ProducingAgentConfig struct {
 	Upgrade *Upgrade `json:"upgrade,omitempty"`
 }
 
+// UnmarshalJSON unmarshals this object while disallowing additional properties from JSON
+func (m *ProducingAgentConfig) UnmarshalJSON(data []byte) error {
+	var props struct {
+
+		// Initial capacity
+		// Required: true
+		Capacity *int64 `json:"capacity"`
+
+		// Degradation rate
+		// Required: true
+		Degradation *int64 `json:"degradation"`
+
+		// Producer identifier
+		// Required: true
+		ID *string `json:"id"`
+
+		// restoration
+		Restoration *Restoration `json:"restoration,omitempty"`
+
+		// Capacity type
+		// Required: true
+		Type *string `json:"type"`
+
+		// upgrade
+		Upgrade *Upgrade `json:"upgrade,omitempty"`
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&props); err != nil {
+		return err
+	}
+
+	m.Capacity = props.Capacity
+	m.Degradation = props.Degradation
+	m.ID = props.ID
+	m.Restoration = props.Restoration
+	m.Type = props.Type
+	m.Upgrade = props.Upgrade
+	return nil
+}
+
 // Validate validates this producing agent config
 func (m *ProducingAgentConfig) Validate(formats strfmt.Registry) error {
 	var res []error
@@ -58,6 +102,10 @@ func (m *ProducingAgentConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRestoration(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -99,6 +147,25 @@ func (m *ProducingAgentConfig) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ProducingAgentConfig) validateRestoration(formats strfmt.Registry) error {
+	if swag.IsZero(m.Restoration) { // not required
+		return nil
+	}
+
+	if m.Restoration != nil {
+		if err := m.Restoration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("restoration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("restoration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ProducingAgentConfig) validateType(formats strfmt.Registry) error {
 
 	if err := validate.Required("type", "body", m.Type); err != nil {
@@ -131,6 +198,10 @@ func (m *ProducingAgentConfig) validateUpgrade(formats strfmt.Registry) error {
 func (m *ProducingAgentConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateRestoration(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateUpgrade(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -141,6 +212,27 @@ func (m *ProducingAgentConfig) ContextValidate(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *ProducingAgentConfig) contextValidateRestoration(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Restoration != nil {
+
+		if swag.IsZero(m.Restoration) { // not required
+			return nil
+		}
+
+		if err := m.Restoration.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("restoration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("restoration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ProducingAgentConfig) contextValidateUpgrade(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Upgrade != nil {
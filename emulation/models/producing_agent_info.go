@@ -23,8 +23,8 @@ type ProducingAgentInfo struct {
 	// Capacity type
 	CapacityType string `json:"capacityType,omitempty"`
 
-	// The cut off price in the previous cycle
-	CutOffPrice int64 `json:"cutOffPrice,omitempty"`
+	// The cut off price in the previous cycle. Absent before the first accepted bid
+	CutOffPrice *float64 `json:"cutOffPrice,omitempty"`
 
 	// Agent ID
 	ID string `json:"id,omitempty"`
@@ -5,7 +5,73 @@ package models
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
 // Restoration restoration
 //
 // swagger:model Restoration
-type Restoration interface{}
+type Restoration struct {
+
+	// Product required for restoration
+	Require int64 `json:"require,omitempty"`
+
+	// Capacity restored after restoration, up to the maximal capacity
+	Restores int64 `json:"restores,omitempty"`
+}
+
+// UnmarshalJSON unmarshals this object while disallowing additional properties from JSON
+func (m *Restoration) UnmarshalJSON(data []byte) error {
+	var props struct {
+
+		// Product required for restoration
+		Require int64 `json:"require,omitempty"`
+
+		// Capacity restored after restoration, up to the maximal capacity
+		Restores int64 `json:"restores,omitempty"`
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&props); err != nil {
+		return err
+	}
+
+	m.Require = props.Require
+	m.Restores = props.Restores
+	return nil
+}
+
+// Validate validates this restoration
+func (m *Restoration) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this restoration based on context it is used
+func (m *Restoration) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Restoration) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Restoration) UnmarshalBinary(b []byte) error {
+	var res Restoration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
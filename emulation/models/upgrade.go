@@ -6,7 +6,9 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -17,11 +19,33 @@ import (
 // swagger:model Upgrade
 type Upgrade struct {
 
-	// Capacity increase after upgrade
-	Capacity int64 `json:"capacity,omitempty"`
+	// Maximal capacity increase after upgrade
+	Increases int64 `json:"increases,omitempty"`
 
 	// Product required for upgrade
-	Product int64 `json:"product,omitempty"`
+	Require int64 `json:"require,omitempty"`
+}
+
+// UnmarshalJSON unmarshals this object while disallowing additional properties from JSON
+func (m *Upgrade) UnmarshalJSON(data []byte) error {
+	var props struct {
+
+		// Maximal capacity increase after upgrade
+		Increases int64 `json:"increases,omitempty"`
+
+		// Product required for upgrade
+		Require int64 `json:"require,omitempty"`
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&props); err != nil {
+		return err
+	}
+
+	m.Increases = props.Increases
+	m.Require = props.Require
+	return nil
 }
 
 // Validate validates this upgrade
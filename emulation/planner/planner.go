@@ -0,0 +1,240 @@
+package planner
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+
+	"emulation/domain"
+)
+
+// Demand is the profile of the consumers' orders per cycle by the product
+type Demand map[domain.Product]float64
+
+// ConsumerDemand is the demand of the configured consumers: every consumer orders one of its products a cycle,
+// chosen uniformly
+func ConsumerDemand(config *domain.Configuration) Demand {
+	result := Demand{}
+	for _, consumer := range config.Consumers {
+		for _, product := range consumer.Products {
+			result[product] += 1 / float64(len(consumer.Products))
+		}
+	}
+	return result
+}
+
+// Plan is the centralized optimum of the steady state: the consumers' orders completed per cycle
+// and the investments sustaining the capacities they need
+type Plan struct {
+	Horizon uint `json:"horizon"`
+	// Throughput is the number of the consumers' orders completed per cycle, Demand is the number ordered
+	Throughput float64                            `json:"throughput"`
+	Demand     float64                            `json:"demand"`
+	Products   map[domain.Product]ProductPlan     `json:"products"`
+	Producers  map[domain.ProducerId]ProducerPlan `json:"producers"`
+}
+
+type ProductPlan struct {
+	Demand     float64 `json:"demand"`
+	Throughput float64 `json:"throughput"`
+}
+
+// ProducerPlan is the investment schedule of a producer: the upgrades done within the horizon
+// and the restorations per cycle keeping the capacity the plan needs
+type ProducerPlan struct {
+	Capacity     float64 `json:"capacity"`
+	MaxCapacity  float64 `json:"maxCapacity"`
+	Restorations float64 `json:"restorations"`
+	Upgrades     float64 `json:"upgrades"`
+}
+
+// epsilon is the cost of an investment, so that the plan doesn't invest beyond the need
+const epsilon = 1e-6
+
+// perturbation of the i-th limit is i times it, far below a capacity unit
+const perturbation = 1e-9
+
+// noise is the magnitude below which a variable is zero but for the perturbation
+const noise = 1e-7
+
+// Solve finds the maximal sustainable throughput of the demand with a linear program. The orders are fractional,
+// the capacities of a type are pooled, the degradation is proportional to the sustained capacity and the upgrades
+// are paid off within the horizon, so the plan bounds the decentralized agents from above. The tokens aren't
+// planned: the plan is of the capacities only, and the decay of the initial capacities is left out.
+func Solve(config *domain.Configuration, demand Demand, horizon uint) (Plan, error) {
+	if err := config.Validate(); err != nil {
+		return Plan{}, fmt.Errorf("invalid configuration: %w", err)
+	}
+	if horizon == 0 {
+		return Plan{}, fmt.Errorf("horizon must be positive")
+	}
+	sheets := map[domain.Product]domain.ProcessSheet{}
+	for _, sheet := range config.ProcessSheets {
+		sheets[sheet.Product] = sheet
+	}
+	for product, orders := range demand {
+		if _, ok := sheets[product]; !ok {
+			return Plan{}, fmt.Errorf("demand of product %v which has no process sheet", product)
+		}
+		if orders < 0 {
+			return Plan{}, fmt.Errorf("demand of product %v must not be negative, got %v", product, orders)
+		}
+	}
+
+	m := newModel(config, demand, horizon)
+	for i, product := range m.products {
+		// the throughput doesn't exceed the demand
+		m.constrain(map[int]float64{i: 1}, demand[product])
+	}
+	for i, producer := range config.ProducerConfigs {
+		// the sustained capacity is within the maximal capacity after the upgrades
+		m.constrain(map[int]float64{m.capacity(i): 1, m.upgrades(i): -float64(producer.Upgrade.Increases)}, float64(producer.Capacity))
+		// the restorations make up for the degradation
+		m.constrain(map[int]float64{m.capacity(i): float64(producer.Degradation) / 100, m.restorations(i): -float64(producer.Restoration.Restores)}, 0)
+		// an investment runs at a time, for a cycle at least
+		m.constrain(map[int]float64{m.restorations(i): 1}, bound(producer.Restoration.Restores, 1))
+		m.constrain(map[int]float64{m.upgrades(i): 1}, bound(producer.Upgrade.Increases, float64(horizon)))
+	}
+	for _, capType := range m.types {
+		// the orders of the consumers and of the investments are within the sustained capacity
+		row := map[int]float64{}
+		for i, product := range m.products {
+			row[i] += float64(sheets[product].Require[capType])
+		}
+		for i, producer := range config.ProducerConfigs {
+			if producer.Type == capType {
+				row[m.capacity(i)] -= 1
+			}
+			if producer.Restoration.Restores > 0 {
+				row[m.restorations(i)] += float64(sheets[producer.Restoration.Require].Require[capType])
+			}
+			if producer.Upgrade.Increases > 0 {
+				row[m.upgrades(i)] += float64(sheets[producer.Upgrade.Require].Require[capType]) / float64(horizon)
+			}
+		}
+		m.constrain(row, 0)
+	}
+
+	x, err := m.solve()
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to solve the plan: %w", err)
+	}
+	return m.plan(config, x), nil
+}
+
+// bound is the upper bound of an investment, none without its capacity
+func bound(capacity domain.Capacity, max float64) float64 {
+	if capacity == 0 {
+		return 0
+	}
+	return max
+}
+
+// model is the linear program maximizing the throughput. The variables are the throughputs of the products
+// followed by the sustained capacity, the restorations per cycle and the upgrades within the horizon of every producer.
+type model struct {
+	products []domain.Product
+	types    []domain.CapacityType
+	horizon  uint
+	demand   Demand
+	nVars    int
+	g        [][]float64
+	h        []float64
+}
+
+func newModel(config *domain.Configuration, demand Demand, horizon uint) *model {
+	types := map[domain.CapacityType]bool{}
+	for _, producer := range config.ProducerConfigs {
+		types[producer.Type] = true
+	}
+	products := make([]domain.Product, len(config.ProcessSheets))
+	for i, sheet := range config.ProcessSheets {
+		products[i] = sheet.Product
+	}
+	slices.Sort(products)
+	return &model{
+		products: products,
+		types:    slices.Sorted(maps.Keys(types)),
+		horizon:  horizon,
+		demand:   demand,
+		nVars:    len(products) + 3*len(config.ProducerConfigs),
+	}
+}
+
+func (m *model) capacity(producer int) int     { return len(m.products) + 3*producer }
+func (m *model) restorations(producer int) int { return len(m.products) + 3*producer + 1 }
+func (m *model) upgrades(producer int) int     { return len(m.products) + 3*producer + 2 }
+
+// constrain adds the constraint sum(coefficient * variable) <= limit
+func (m *model) constrain(coefficients map[int]float64, limit float64) {
+	row := make([]float64, m.nVars)
+	for i, c := range coefficients {
+		row[i] = c
+	}
+	m.g = append(m.g, row)
+	m.h = append(m.h, limit)
+}
+
+// solve minimizes the negated throughput with the costs of the investments. All the variables are non-negative
+// and all the limits are, so the standard form is G*x + s = h with the slacks s as the initial feasible basis.
+func (m *model) solve() ([]float64, error) {
+	rows := len(m.g)
+	c := make([]float64, m.nVars+rows)
+	for i := range m.nVars {
+		c[i] = epsilon
+	}
+	for i := range m.products {
+		c[i] = -1
+	}
+	a := mat.NewDense(rows, m.nVars+rows, nil)
+	basic := make([]int, rows)
+	for i, row := range m.g {
+		for j, v := range row {
+			a.Set(i, j, v)
+		}
+		a.Set(i, m.nVars+i, 1)
+		basic[i] = m.nVars + i
+	}
+	// the zero limits make the program degenerate, which the simplex fails on, so the limits are perturbed
+	h := make([]float64, rows)
+	for i, limit := range m.h {
+		h[i] = limit + perturbation*float64(i+1)
+	}
+	_, x, err := lp.Simplex(c, a, h, 1e-10, basic)
+	if err != nil {
+		return nil, err
+	}
+	x = x[:m.nVars]
+	for i, v := range x {
+		if v < noise {
+			x[i] = 0
+		}
+	}
+	return x, nil
+}
+
+func (m *model) plan(config *domain.Configuration, x []float64) Plan {
+	result := Plan{
+		Horizon:   m.horizon,
+		Products:  map[domain.Product]ProductPlan{},
+		Producers: map[domain.ProducerId]ProducerPlan{},
+	}
+	for i, product := range m.products {
+		result.Products[product] = ProductPlan{m.demand[product], x[i]}
+		result.Throughput += x[i]
+		result.Demand += m.demand[product]
+	}
+	for i, producer := range config.ProducerConfigs {
+		upgrades := x[m.upgrades(i)]
+		result.Producers[producer.Id] = ProducerPlan{
+			Capacity:     x[m.capacity(i)],
+			MaxCapacity:  float64(producer.Capacity) + upgrades*float64(producer.Upgrade.Increases),
+			Restorations: x[m.restorations(i)],
+			Upgrades:     upgrades,
+		}
+	}
+	return result
+}
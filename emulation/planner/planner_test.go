@@ -0,0 +1,127 @@
+package planner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"emulation/domain"
+	"emulation/generator"
+	"emulation/strategy"
+)
+
+// testConfig has a single producer restoring with the product the consumer orders
+func testConfig() *domain.Configuration {
+	return &domain.Configuration{
+		CycleEmission: 100,
+		ProcessSheets: []domain.ProcessSheet{
+			{Product: 1, Require: map[domain.CapacityType]domain.Capacity{"a": 10}},
+		},
+		ProducerConfigs: []domain.ProducingAgentConfig{
+			{Id: "p1", Type: "a", Capacity: 100, Degradation: 10, Restoration: domain.Restoration{Require: 1, Restores: 20}},
+		},
+		Consumers: []domain.ConsumerConfig{{Id: "c1", Products: []domain.Product{1}}},
+	}
+}
+
+func TestSolve(t *testing.T) {
+	t.Run(`Given a producer with enough capacity for the demand
+		When the plan is solved
+		Then the whole demand is served with the restorations making up for the degradation of the needed capacity`, func(t *testing.T) {
+
+		plan, err := Solve(testConfig(), ConsumerDemand(testConfig()), 100)
+		require.NoError(t, err)
+		require.InDelta(t, 1, plan.Throughput, 1e-6)
+		require.Equal(t, 1.0, plan.Demand)
+		// capacity = 10 for the order + 10 * restorations, restorations = 10% * capacity / 20
+		require.InDelta(t, 10/0.95, plan.Producers["p1"].Capacity, 1e-6)
+		require.InDelta(t, 0.05/0.95, plan.Producers["p1"].Restorations, 1e-6)
+		require.Zero(t, plan.Producers["p1"].Upgrades)
+	})
+
+	t.Run(`Given a product requiring more capacity than the producer has
+		When the plan is solved with and without the upgrades
+		Then the upgrades raise the throughput`, func(t *testing.T) {
+
+		config := testConfig()
+		config.ProcessSheets[0].Require["a"] = 150
+		plan, err := Solve(config, ConsumerDemand(config), 100)
+		require.NoError(t, err)
+		// capacity 100 = 150 * (throughput + restorations), restorations = 10% * 100 / 20
+		require.InDelta(t, 100.0/150-0.5, plan.Throughput, 1e-6)
+		require.InDelta(t, 0.5, plan.Producers["p1"].Restorations, 1e-6)
+
+		config.ProducerConfigs[0].Upgrade = domain.Upgrade{Require: 1, Increases: 100}
+		upgraded, err := Solve(config, ConsumerDemand(config), 100)
+		require.NoError(t, err)
+		require.Greater(t, upgraded.Throughput, plan.Throughput)
+		require.Positive(t, upgraded.Producers["p1"].Upgrades)
+		require.Greater(t, upgraded.Producers["p1"].MaxCapacity, 100.0)
+	})
+
+	t.Run(`Given generated economies with the capacities cut down
+		When the plans are solved
+		Then every plan is found within the demand`, func(t *testing.T) {
+
+		for seed := range uint64(100) {
+			config, err := generator.Generate(generator.Params{
+				Seed: seed, CapacityTypes: 1 + int(seed%6), ProducersPerType: 1 + int(seed%3), Products: 2 + int(seed%7),
+				Complexity: []float64{1}, Requirement: generator.Range{Min: 5, Max: 80}, Degradation: generator.Range{Min: 0, Max: 30},
+				CrossDependency: 0.5, Consumers: 3, ProductsPerConsumer: 2, Headroom: 1, Emission: 3,
+			})
+			require.NoError(t, err)
+			for i := range config.ProducerConfigs {
+				config.ProducerConfigs[i].Capacity = max(1, config.ProducerConfigs[i].Capacity/domain.Capacity(1+seed%4))
+			}
+			plan, err := Solve(config, ConsumerDemand(config), 100)
+			require.NoError(t, err, "seed %d", seed)
+			require.LessOrEqual(t, plan.Throughput, plan.Demand+1e-6)
+			require.GreaterOrEqual(t, plan.Throughput, 0.0)
+		}
+	})
+
+	t.Run(`Given a demand profile
+		When the plan is solved
+		Then the throughput follows the profile, the invalid profiles are rejected`, func(t *testing.T) {
+
+		plan, err := Solve(testConfig(), Demand{1: 0.5}, 100)
+		require.NoError(t, err)
+		require.InDelta(t, 0.5, plan.Throughput, 1e-6)
+		require.Equal(t, ProductPlan{0.5, plan.Throughput}, plan.Products[1])
+
+		_, err = Solve(testConfig(), Demand{2: 1}, 100)
+		require.ErrorContains(t, err, "product 2 which has no process sheet")
+		_, err = Solve(testConfig(), Demand{1: -1}, 100)
+		require.ErrorContains(t, err, "must not be negative")
+		_, err = Solve(testConfig(), Demand{1: 1}, 0)
+		require.ErrorContains(t, err, "horizon must be positive")
+	})
+}
+
+func TestCompareRuns(t *testing.T) {
+	t.Run(`Given a configuration played by bots
+		When the runs are compared with the plan
+		Then the regret is the planned throughput the runs miss`, func(t *testing.T) {
+
+		config := testConfig()
+		config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{
+			"c1": {Name: strategy.ProportionalToCapacityName},
+			"p1": {Name: strategy.ProportionalToPriceName},
+		}
+		config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{"p1": {Name: strategy.ThresholdName}}
+		plan, err := Solve(config, ConsumerDemand(config), 50)
+		require.NoError(t, err)
+
+		regret, err := CompareRuns(config, plan, RegretOptions{Cycles: 50, Warmup: 10, Seeds: 3, Seed: 1})
+		require.NoError(t, err)
+		require.Len(t, regret.Runs, 3)
+		require.Equal(t, uint64(3), regret.Runs[2].Seed)
+		require.Positive(t, regret.Throughput)
+		require.LessOrEqual(t, regret.Throughput, plan.Throughput+1e-6)
+		require.InDelta(t, plan.Throughput-regret.Throughput, regret.Regret, 1e-9)
+		require.InDelta(t, regret.Regret/plan.Throughput, regret.Relative, 1e-9)
+
+		_, err = CompareRuns(config, plan, RegretOptions{Cycles: 10, Warmup: 10, Seeds: 1})
+		require.ErrorContains(t, err, "cycles must exceed the warm-up")
+	})
+}
@@ -0,0 +1,84 @@
+package planner
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"emulation/domain"
+	"emulation/simulation"
+)
+
+// RegretOptions of the runs the plan is compared with
+type RegretOptions struct {
+	Cycles uint
+	// Warmup cycles are left out of the throughput while the capacities of the run settle
+	Warmup  uint
+	Seeds   int
+	Seed    uint64
+	Workers int
+}
+
+// RunThroughput is the number of the consumers' orders completed per cycle of a run after the warm-up
+type RunThroughput struct {
+	Seed       uint64  `json:"seed"`
+	Throughput float64 `json:"throughput"`
+}
+
+// Regret compares the plan with the runs of the bots: the throughput the decentralized agents miss per cycle
+type Regret struct {
+	Plan Plan            `json:"plan"`
+	Runs []RunThroughput `json:"runs"`
+	// Throughput is the mean throughput of the runs
+	Throughput float64 `json:"throughput"`
+	// Regret is the planned throughput less the one of the runs, Relative is its share of the planned throughput
+	Regret   float64 `json:"regret"`
+	Relative float64 `json:"relative"`
+}
+
+// CompareRuns plays the configuration with its bots and the consecutive seeds and measures the regret of the plan.
+// The runs play the configured consumers, so the plan is to be of their demand.
+func CompareRuns(config *domain.Configuration, plan Plan, opts RegretOptions) (Regret, error) {
+	if opts.Seeds < 1 || opts.Cycles <= opts.Warmup {
+		return Regret{}, fmt.Errorf("seeds must be positive and cycles must exceed the warm-up, got %d seeds, %d cycles and %d warm-up cycles",
+			opts.Seeds, opts.Cycles, opts.Warmup)
+	}
+	consumers := lo.SliceToMap(config.Consumers, func(c domain.ConsumerConfig) (domain.OrderingAgentId, bool) {
+		return domain.FromConsumerId(c.Id), true
+	})
+	runs := make([]RunThroughput, opts.Seeds)
+	err := simulation.ForEach(opts.Seeds, opts.Workers, func(i int) error {
+		seed := opts.Seed + uint64(i)
+		completed := uint(0)
+		_, err := simulation.Run(config, simulation.Options{
+			Cycles: opts.Cycles,
+			Seed:   seed,
+			OnCycle: func(cycle uint, result domain.CycleResult) {
+				if cycle <= opts.Warmup {
+					return
+				}
+				for agentId, n := range result.Completed {
+					if consumers[agentId] {
+						completed += n
+					}
+				}
+			},
+		})
+		runs[i] = RunThroughput{seed, float64(completed) / float64(opts.Cycles-opts.Warmup)}
+		return err
+	})
+	if err != nil {
+		return Regret{}, err
+	}
+
+	result := Regret{
+		Plan:       plan,
+		Runs:       runs,
+		Throughput: lo.MeanBy(runs, func(r RunThroughput) float64 { return r.Throughput }),
+	}
+	result.Regret = plan.Throughput - result.Throughput
+	if plan.Throughput > 0 {
+		result.Relative = result.Regret / plan.Throughput
+	}
+	return result, nil
+}
@@ -3,16 +3,28 @@
 package restapi
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"log"
+	"maps"
+	"math"
 	"net/http"
+	"os"
+	"slices"
+	"time"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/samber/lo"
 
 	"emulation/application"
 	"emulation/domain"
+	"emulation/gym"
 	"emulation/models"
 	"emulation/restapi/operations"
 	"emulation/restapi/operations/tokenomics"
@@ -20,8 +32,39 @@ import (
 
 //go:generate swagger generate server --target ../../emulation --name Tokenomics --spec ../swagger.yaml --principal models.Principal
 
+var authOptions struct {
+	APIKeys string `long:"api-keys" env:"TOKENOMICS_API_KEYS" description:"JSON file with the API keys, the API is open without it"`
+}
+
+var configOptions struct {
+	Config    string `short:"c" long:"config" env:"TOKENOMICS_CONFIG" default:"config.json" description:"JSON configuration file of the default session and of the sessions created without a configuration"`
+	Scenarios string `long:"scenarios" env:"TOKENOMICS_SCENARIOS" default:"scenarios" description:"directory of the JSON and YAML scenarios the sessions are reset into"`
+}
+
+var persistenceOptions struct {
+	Snapshot   string `long:"snapshot" env:"TOKENOMICS_SNAPSHOT" description:"JSON file the default session is restored from at start, if it exists, and saved to at shutdown"`
+	CommandLog string `long:"command-log" env:"TOKENOMICS_COMMAND_LOG" description:"file the commands of the default session are appended to as JSON lines"`
+	Store      string `long:"store" env:"TOKENOMICS_STORE" description:"bbolt file the runs of all sessions are saved to with their cycles, market history and events"`
+}
+
 func configureFlags(api *operations.TokenomicsAPI) {
-	// api.CommandLineOptionsGroups = []swag.CommandLineOptionsGroup{ ... }
+	api.CommandLineOptionsGroups = []swag.CommandLineOptionsGroup{
+		{
+			ShortDescription: "Authentication",
+			LongDescription:  "API keys binding the clients to the agents they may command",
+			Options:          &authOptions,
+		},
+		{
+			ShortDescription: "Configuration",
+			LongDescription:  "Configuration file the sessions start with and are reverted to, and the scenario library",
+			Options:          &configOptions,
+		},
+		{
+			ShortDescription: "Persistence",
+			LongDescription:  "Saving the default session between the server restarts",
+			Options:          &persistenceOptions,
+		},
+	}
 }
 
 func configureAPI(api *operations.TokenomicsAPI) http.Handler {
@@ -42,9 +85,101 @@ func configureAPI(api *operations.TokenomicsAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
-	emulator := application.NewEmulator()
+	// fileConfig reads the configuration file on every use, so that its edits are taken
+	fileConfig := func() (*domain.Configuration, error) {
+		return application.LoadConfig(configOptions.Config)
+	}
+	scenarios := application.NewScenarioLibrary(configOptions.Scenarios)
+	sessions := application.NewSessions()
+	var store application.Store
+	if path := persistenceOptions.Store; path != "" {
+		var err error
+		if store, err = application.OpenBoltStore(path); err != nil {
+			log.Fatal(err)
+		}
+		sessions.Persist(store)
+	}
+	// without a valid configuration file the server starts with no sessions,
+	// they are created with the configurations of the requests
+	var defaultSession *application.Emulator
+	if config, err := fileConfig(); err != nil {
+		log.Printf("The default session is not created: %v", err)
+	} else if _, defaultSession, err = sessions.Create(application.DefaultSession, config, 0); err != nil {
+		log.Printf("The default session is not created: %v", err)
+	}
+	var commandLog *os.File
+	if defaultSession != nil {
+		if path := persistenceOptions.Snapshot; path != "" {
+			if _, err := os.Stat(path); err == nil {
+				if err := defaultSession.LoadSnapshot(path); err != nil {
+					log.Fatalf("Failed to restore the default session: %v", err)
+				}
+			}
+		}
+		if path := persistenceOptions.CommandLog; path != "" {
+			var err error
+			if commandLog, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644); err != nil {
+				log.Fatalf("Failed to open the command log: %v", err)
+			}
+			if err := defaultSession.RecordCommands(commandLog); err != nil {
+				log.Fatalf("Failed to write the command log: %v", err)
+			}
+		}
+	}
+	// session finds the emulator of the requested session
+	session := func(id string) (*application.Emulator, middleware.Responder) {
+		emulator, err := sessions.Get(id)
+		if err != nil {
+			return nil, middleware.Error(http.StatusNotFound, err.Error())
+		}
+		return emulator, nil
+	}
 
-	api.TokenomicsCompleteCycleHandler = tokenomics.CompleteCycleHandlerFunc(func(params tokenomics.CompleteCycleParams) middleware.Responder {
+	keys := application.APIKeys{}
+	if authOptions.APIKeys != "" {
+		var err error
+		if keys, err = application.LoadAPIKeys(authOptions.APIKeys); err != nil {
+			log.Fatalf("Failed to load API keys: %v", err)
+		}
+	}
+	api.APIKeyAuth = func(token string) (*models.Principal, error) {
+		key, err := keys.Authenticate(token)
+		if err != nil {
+			return nil, errors.New(http.StatusUnauthorized, "unknown API key")
+		}
+		return &models.Principal{
+			Name:  key.Name,
+			Admin: key.Admin,
+			OrderingAgents: lo.Map(key.OrderingAgents, func(id domain.OrderingAgentId, _ int) string {
+				return string(id)
+			}),
+			Producers: lo.Map(key.Producers, func(id domain.ProducerId, _ int) string {
+				return string(id)
+			}),
+		}, nil
+	}
+	// forbidden responds with an error unless the principal is allowed or the API is open
+	forbidden := func(principal *models.Principal, allowed func(*models.Principal) bool) middleware.Responder {
+		switch {
+		case len(keys) == 0:
+			return nil
+		case principal == nil:
+			return middleware.Error(http.StatusUnauthorized, "API key required")
+		case !allowed(principal):
+			return middleware.Error(http.StatusForbidden, fmt.Sprintf("API key [%s] is not allowed for this operation", principal.Name))
+		}
+		return nil
+	}
+	admin := func(p *models.Principal) bool { return p.Admin }
+
+	api.TokenomicsCompleteCycleHandler = tokenomics.CompleteCycleHandlerFunc(func(params tokenomics.CompleteCycleParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
 		result, err := emulator.CompleteCycle()
 		if err != nil {
 			return middleware.Error(http.StatusBadRequest, err.Error())
@@ -55,56 +190,170 @@ func configureAPI(api *operations.TokenomicsAPI) http.Handler {
 	})
 
 	api.GetOrderingAgentViewHandler = operations.GetOrderingAgentViewHandlerFunc(func(params operations.GetOrderingAgentViewParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
 		result, err := emulator.GetOrderingAgentView(domain.OrderingAgentId(params.ID))
 		if err != nil {
 			return middleware.Error(http.StatusBadRequest, err.Error())
 		}
-		return operations.NewGetOrderingAgentViewOK().WithPayload(&models.OrderingAgentView{
-			lo.MapEntries(result.Incoming, func(oid domain.OrderId, val map[domain.CapacityType]domain.Capacity) (string, map[string]int64) {
-				return string(oid), lo.MapEntries(val, func(ct domain.CapacityType, cap domain.Capacity) (string, int64) {
-					return string(ct), int64(cap)
-				})
-			}),
-			lo.MapEntries(result.Producers, func(ct domain.CapacityType, val map[domain.ProducerId]domain.ProducerInfo) (string, map[string]models.ProducingAgentInfo) {
-				return string(ct), lo.MapEntries(val, func(pId domain.ProducerId, pInfo domain.ProducerInfo) (string, models.ProducingAgentInfo) {
-					return string(pId), models.ProducingAgentInfo{
-						int64(pInfo.Capacity),
-						string(pInfo.CapacityType),
-						int64(pInfo.CutOffPrice),
-						string(pInfo.Id),
-						int64(pInfo.MaxCapacity),
-					}
-				})
-			}),
-		})
+		return operations.NewGetOrderingAgentViewOK().WithPayload(orderingAgentViewModel(result))
 	})
 
 	api.GetProducingAgentViewHandler = operations.GetProducingAgentViewHandlerFunc(func(params operations.GetProducingAgentViewParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
 		result, err := emulator.GetProducingAgentView(domain.ProducerId(params.ID))
 		if err != nil {
 			return middleware.Error(http.StatusBadRequest, err.Error())
 		}
-		return operations.NewGetProducingAgentViewOK().WithPayload(&models.ProducingAgentView{
-			int64(result.Capacity),
-			int64(result.Degradation),
-			string(result.Id),
-			int64(result.MaxCapacity),
-			int64(result.RequestedCapacity),
-			int64(result.Restoration),
-			bool(result.RestorationRunning),
-			int64(result.Upgrade),
-			bool(result.UpgradeRunning),
-		})
+		return operations.NewGetProducingAgentViewOK().WithPayload(producingAgentViewModel(result))
+	})
+
+	api.StreamEventsHandler = operations.StreamEventsHandlerFunc(func(params operations.StreamEventsParams, principal *models.Principal) middleware.Responder {
+		ids := agentIds(params.AgentID)
+		if r := forbidden(principal, func(p *models.Principal) bool { return p.CanWatch(ids) }); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		events, unsubscribe := emulator.Subscribe(ids)
+		return sseResponder(params.HTTPRequest, events, unsubscribe)
+	})
+
+	api.StreamEventsWebSocketHandler = operations.StreamEventsWebSocketHandlerFunc(func(params operations.StreamEventsWebSocketParams, principal *models.Principal) middleware.Responder {
+		ids := agentIds(params.AgentID)
+		if r := forbidden(principal, func(p *models.Principal) bool { return p.CanWatch(ids) }); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		events, unsubscribe := emulator.Subscribe(ids)
+		return webSocketResponder(params.HTTPRequest, events, unsubscribe)
+	})
+
+	api.GetMarketHistoryHandler = operations.GetMarketHistoryHandlerFunc(func(params operations.GetMarketHistoryParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		records := emulator.GetMarketHistory(
+			uint(max(0, lo.FromPtr(params.FromCycle))),
+			uint(max(0, lo.FromPtrOr(params.ToCycle, math.MaxInt64))))
+		if params.ProducerID != nil {
+			records = lo.Filter(records, func(r domain.MarketRecord, _ int) bool {
+				return r.ProducerId == domain.ProducerId(*params.ProducerID)
+			})
+		}
+		return operations.NewGetMarketHistoryOK().WithPayload(lo.Map(records, func(r domain.MarketRecord, _ int) *models.MarketRecord {
+			return &models.MarketRecord{
+				Cycle:             int64(r.Cycle),
+				ProducerID:        string(r.ProducerId),
+				CapacityType:      string(r.CapacityType),
+				CutOffPrice:       priceModel(r.CutOffPrice),
+				AvailableCapacity: int64(r.AvailableCapacity),
+				RequestedCapacity: int64(r.RequestedCapacity),
+				AcceptedCapacity:  int64(r.AcceptedCapacity),
+				RejectedCapacity:  int64(r.RejectedCapacity),
+				Funds:             int64(r.Funds),
+			}
+		}))
 	})
 
 	api.GetSystemInfoHandler = operations.GetSystemInfoHandlerFunc(func(params operations.GetSystemInfoParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
 		info := emulator.GetSystemInfo()
 		return operations.NewGetSystemInfoOK().WithPayload([]*models.SystemInfo{
 			&info,
 		})
 	})
 
+	api.GetMissedDeadlinesHandler = operations.GetMissedDeadlinesHandlerFunc(func(params operations.GetMissedDeadlinesParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		missed := emulator.GetMissedDeadlines()
+		result := make([]*models.MissedDeadlines, 0, len(missed.Producing)+len(missed.Ordering))
+		for _, id := range slices.Sorted(maps.Keys(missed.Producing)) {
+			result = append(result, &models.MissedDeadlines{
+				AgentID: lo.ToPtr(string(id)),
+				Phase:   lo.ToPtr("investment"),
+				Missed:  lo.ToPtr(int64(missed.Producing[id])),
+			})
+		}
+		for _, id := range slices.Sorted(maps.Keys(missed.Ordering)) {
+			result = append(result, &models.MissedDeadlines{
+				AgentID: lo.ToPtr(string(id)),
+				Phase:   lo.ToPtr("ordering"),
+				Missed:  lo.ToPtr(int64(missed.Ordering[id])),
+			})
+		}
+		return operations.NewGetMissedDeadlinesOK().WithPayload(result)
+	})
+
+	api.TokenomicsExportSnapshotHandler = tokenomics.ExportSnapshotHandlerFunc(func(params tokenomics.ExportSnapshotParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		snapshot, err := emulator.Snapshot()
+		if err != nil {
+			return middleware.Error(http.StatusInternalServerError, err.Error())
+		}
+		return tokenomics.NewExportSnapshotOK().WithPayload(snapshot)
+	})
+
+	api.TokenomicsImportSnapshotHandler = tokenomics.ImportSnapshotHandlerFunc(func(params tokenomics.ImportSnapshotParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		snapshot, err := snapshot(params.Body)
+		if err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		if err := emulator.Restore(snapshot); err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		return tokenomics.NewImportSnapshotOK()
+	})
+
+	api.TokenomicsGetCommandLogHandler = tokenomics.GetCommandLogHandlerFunc(func(params tokenomics.GetCommandLogParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		commands := emulator.Commands(uint64(max(0, lo.FromPtr(params.FromSeq))))
+		return tokenomics.NewGetCommandLogOK().WithPayload(lo.Map(commands, func(c application.Command, _ int) models.Command {
+			return c
+		}))
+	})
+
 	api.ListOrderingAgentsHandler = operations.ListOrderingAgentsHandlerFunc(func(params operations.ListOrderingAgentsParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
 		orderingAgents := emulator.GetOrderingAgentInfos()
 		result := make([]*models.OrderingAgentInfo, 0, len(orderingAgents))
 		for _, info := range orderingAgents {
@@ -116,13 +365,17 @@ func configureAPI(api *operations.TokenomicsAPI) http.Handler {
 	})
 
 	api.ListProducingAgentsHandler = operations.ListProducingAgentsHandlerFunc(func(params operations.ListProducingAgentsParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
 		producerInfos := emulator.GetProducerInfos()
 		result := make([]*models.ProducingAgentInfo, 0, len(producerInfos))
 		for _, info := range producerInfos {
 			result = append(result, &models.ProducingAgentInfo{
 				int64(info.Capacity),
 				string(info.CapacityType),
-				int64(info.CutOffPrice),
+				priceModel(info.CutOffPrice),
 				string(info.Id),
 				int64(info.MaxCapacity),
 			})
@@ -130,37 +383,101 @@ func configureAPI(api *operations.TokenomicsAPI) http.Handler {
 		return operations.NewListProducingAgentsOK().WithPayload(result)
 	})
 
-	api.TokenomicsResetSystemHandler = tokenomics.ResetSystemHandlerFunc(func(params tokenomics.ResetSystemParams) middleware.Responder {
-		emulator.Reset()
+	api.TokenomicsResetSystemHandler = tokenomics.ResetSystemHandlerFunc(func(params tokenomics.ResetSystemParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		if err := emulator.Reset(); err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
 		return tokenomics.NewResetSystemOK()
 	})
 
-	api.SendOrderingAgentCommandHandler = operations.SendOrderingAgentCommandHandlerFunc(func(params operations.SendOrderingAgentCommandParams) middleware.Responder {
-		err := emulator.OrderingAgentAction(domain.OrderingAgentId(params.ID), domain.OrderingAgentCommand{
-			lo.MapEntries(params.Body.Orders, func(orderId string, producers map[string]int64) (domain.OrderId, map[domain.ProducerId]domain.Tokens) {
-				return domain.OrderId(orderId), lo.MapEntries(producers, func(producerId string, tokens int64) (domain.ProducerId, domain.Tokens) {
-					return domain.ProducerId(producerId), domain.Tokens(tokens)
-				})
-			}),
-		})
+	api.SendOrderingAgentCommandHandler = operations.SendOrderingAgentCommandHandlerFunc(func(params operations.SendOrderingAgentCommandParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, func(p *models.Principal) bool { return p.CanOrder(params.ID) }); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		err := emulator.OrderingAgentAction(domain.OrderingAgentId(params.ID), orderingAgentCommand(params.Body))
 		if err != nil {
 			return middleware.Error(http.StatusBadRequest, err.Error())
 		}
 		return operations.NewSendOrderingAgentCommandOK()
 	})
 
-	api.SendProducingAgentCommandHandler = operations.SendProducingAgentCommandHandlerFunc(func(params operations.SendProducingAgentCommandParams) middleware.Responder {
-		err := emulator.ProducingAgentAction(domain.ProducerId(params.ID), domain.ProducingAgentCommand{
-			params.Body.DoRestoration,
-			params.Body.DoUpgrade,
-		})
+	api.SendProducingAgentCommandHandler = operations.SendProducingAgentCommandHandlerFunc(func(params operations.SendProducingAgentCommandParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, func(p *models.Principal) bool { return p.CanProduce(params.ID) }); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		err := emulator.ProducingAgentAction(domain.ProducerId(params.ID), producingAgentCommand(params.Body))
 		if err != nil {
 			return middleware.Error(http.StatusBadRequest, err.Error())
 		}
 		return operations.NewSendProducingAgentCommandOK()
 	})
 
-	api.TokenomicsStartOrderingHandler = tokenomics.StartOrderingHandlerFunc(func(params tokenomics.StartOrderingParams) middleware.Responder {
+	api.PassOrderingAgentHandler = operations.PassOrderingAgentHandlerFunc(func(params operations.PassOrderingAgentParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, func(p *models.Principal) bool { return p.CanOrder(params.ID) }); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		if err := emulator.OrderingAgentPass(domain.OrderingAgentId(params.ID)); err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		return operations.NewPassOrderingAgentOK()
+	})
+
+	api.PassProducingAgentHandler = operations.PassProducingAgentHandlerFunc(func(params operations.PassProducingAgentParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, func(p *models.Principal) bool { return p.CanProduce(params.ID) }); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		if err := emulator.ProducingAgentPass(domain.ProducerId(params.ID)); err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		return operations.NewPassProducingAgentOK()
+	})
+
+	api.WaitPhaseHandler = operations.WaitPhaseHandlerFunc(func(params operations.WaitPhaseParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		timeout := time.Duration(min(max(0, lo.FromPtrOr(params.TimeoutMs, 30_000)), 300_000)) * time.Millisecond
+		ctx, cancel := context.WithTimeout(params.HTTPRequest.Context(), timeout)
+		defer cancel()
+		info := emulator.WaitPhase(ctx, models.SystemInfo{
+			CycleCounter: lo.FromPtr(params.CycleCounter),
+			State:        lo.FromPtr(params.State),
+		})
+		return operations.NewWaitPhaseOK().WithPayload(&info)
+	})
+
+	api.TokenomicsStartOrderingHandler = tokenomics.StartOrderingHandlerFunc(func(params tokenomics.StartOrderingParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
 		err := emulator.StartOrdering()
 		if err != nil {
 			return middleware.Error(http.StatusBadRequest, err.Error())
@@ -169,77 +486,375 @@ func configureAPI(api *operations.TokenomicsAPI) http.Handler {
 	})
 
 	api.GetConfigHandler = operations.GetConfigHandlerFunc(func(params operations.GetConfigParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
 		config := emulator.GetConfig()
 		if config == nil {
 			return middleware.Error(http.StatusNotFound, "configuration not found")
 		}
 
-		return operations.NewGetConfigOK().WithPayload(&models.Configuration{
-			CycleEmission: lo.ToPtr(int64(config.CycleEmission)),
-			ProcessSheets: lo.Map(config.ProcessSheets, func(ps domain.ProcessSheet, _ int) *models.ProcessSheet {
-				return &models.ProcessSheet{
-					Product: lo.ToPtr(int64(ps.Product)),
-					Require: lo.MapEntries(ps.Require, func(ct domain.CapacityType, cap domain.Capacity) (string, int64) {
-						return string(ct), int64(cap)
-					}),
-				}
+		return operations.NewGetConfigOK().WithPayload(configurationModel(config))
+	})
+
+	api.UpdateConfigHandler = operations.UpdateConfigHandlerFunc(func(params operations.UpdateConfigParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		config, err := configuration(params.Body)
+		if err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		if err := emulator.UpdateConfig(config, lo.FromPtr(params.Apply)); err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		return operations.NewUpdateConfigOK()
+	})
+
+	api.RevertConfigHandler = operations.RevertConfigHandlerFunc(func(params operations.RevertConfigParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		config, err := fileConfig()
+		if err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		if err := emulator.UpdateConfig(config, lo.FromPtr(params.Apply)); err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		return operations.NewRevertConfigOK().WithPayload(configurationModel(config))
+	})
+
+	api.AnalyzeSessionConfigHandler = operations.AnalyzeSessionConfigHandlerFunc(func(params operations.AnalyzeSessionConfigParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		return operations.NewAnalyzeSessionConfigOK().WithPayload(configAnalysisModel(emulator.GetConfig().Analyze()))
+	})
+
+	api.AnalyzeConfigHandler = operations.AnalyzeConfigHandlerFunc(func(params operations.AnalyzeConfigParams) middleware.Responder {
+		config, err := convertConfiguration(params.Body)
+		if err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		return operations.NewAnalyzeConfigOK().WithPayload(configAnalysisModel(config.Analyze()))
+	})
+
+	api.ListScenariosHandler = operations.ListScenariosHandlerFunc(func(params operations.ListScenariosParams) middleware.Responder {
+		list, err := scenarios.List()
+		if err != nil {
+			return middleware.Error(http.StatusInternalServerError, err.Error())
+		}
+		return operations.NewListScenariosOK().WithPayload(lo.Map(list, func(s application.Scenario, _ int) *models.Scenario {
+			return scenarioModel(s)
+		}))
+	})
+
+	api.GetScenarioHandler = operations.GetScenarioHandlerFunc(func(params operations.GetScenarioParams) middleware.Responder {
+		scenario, err := scenarios.Get(params.Name)
+		if err != nil {
+			return scenarioError(err)
+		}
+		return operations.NewGetScenarioOK().WithPayload(scenarioModel(scenario))
+	})
+
+	api.LoadScenarioHandler = operations.LoadScenarioHandlerFunc(func(params operations.LoadScenarioParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		if err := emulator.LoadScenario(scenarios, lo.FromPtr(params.Body.Name)); err != nil {
+			return scenarioError(err)
+		}
+		return operations.NewLoadScenarioOK().WithPayload(sessionInfoModel(params.SessionID, emulator))
+	})
+
+	api.ResetGymHandler = operations.ResetGymHandlerFunc(func(params operations.ResetGymParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		cycles := lo.FromPtr(params.Body.Cycles)
+		if params.Body.Seed < 0 || cycles < 0 {
+			return middleware.Error(http.StatusBadRequest, "seed and cycles must not be negative")
+		}
+		observation, err := emulator.ResetGym(uint64(params.Body.Seed), uint(cycles))
+		if err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		return operations.NewResetGymOK().WithPayload(gymObservationModel(observation))
+	})
+
+	api.StepGymHandler = operations.StepGymHandlerFunc(func(params operations.StepGymParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		step, err := emulator.StepGym(gym.Actions{
+			Ordering: lo.SliceToMap(params.Body.Ordering, func(a *models.GymOrderingAction) (domain.OrderingAgentId, domain.OrderingAgentCommand) {
+				return domain.OrderingAgentId(lo.FromPtr(a.AgentID)), orderingAgentCommand(a.Command)
 			}),
-			ProducerConfigs: lo.Map(config.ProducerConfigs, func(pc domain.ProducingAgentConfig, _ int) *models.ProducingAgentConfig {
-				return &models.ProducingAgentConfig{
-					ID:          lo.ToPtr(string(pc.Id)),
-					Type:        lo.ToPtr(string(pc.Type)),
-					Capacity:    lo.ToPtr(int64(pc.Capacity)),
-					Degradation: lo.ToPtr(int64(pc.Degradation)),
-					Restoration: struct{}{},
-					Upgrade: &models.Upgrade{
-						Product:  int64(pc.Upgrade.Require),
-						Capacity: int64(pc.Upgrade.Increases),
-					},
-				}
+			Producing: lo.SliceToMap(params.Body.Producing, func(a *models.GymProducingAction) (domain.ProducerId, domain.ProducingAgentCommand) {
+				return domain.ProducerId(lo.FromPtr(a.ProducerID)), producingAgentCommand(a.Command)
 			}),
 		})
-	})
-
-	api.UpdateConfigHandler = operations.UpdateConfigHandlerFunc(func(params operations.UpdateConfigParams) middleware.Responder {
-		config := &domain.Configuration{
-			CycleEmission: domain.Tokens(lo.FromPtr(params.Body.CycleEmission)),
-			ProcessSheets: lo.Map(params.Body.ProcessSheets, func(ps *models.ProcessSheet, _ int) domain.ProcessSheet {
-				return domain.ProcessSheet{
-					Product: domain.Product(lo.FromPtr(ps.Product)),
-					Require: lo.MapEntries(ps.Require, func(ct string, cap int64) (domain.CapacityType, domain.Capacity) {
-						return domain.CapacityType(ct), domain.Capacity(cap)
-					}),
-				}
+		if err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		return operations.NewStepGymOK().WithPayload(&models.GymStepResult{
+			Observation: gymObservationModel(step.Observation),
+			OrderingRewards: lo.MapKeys(step.Rewards.Ordering, func(_ float64, id domain.OrderingAgentId) string {
+				return string(id)
 			}),
-			ProducerConfigs: lo.Map(params.Body.ProducerConfigs, func(pc *models.ProducingAgentConfig, _ int) domain.ProducingAgentConfig {
-				return domain.ProducingAgentConfig{
-					Id:          domain.ProducerId(lo.FromPtr(pc.ID)),
-					Type:        domain.CapacityType(lo.FromPtr(pc.Type)),
-					Capacity:    domain.Capacity(lo.FromPtr(pc.Capacity)),
-					Degradation: domain.DegradationRate(lo.FromPtr(pc.Degradation)),
-					Restoration: domain.Restoration{},
-					Upgrade: domain.Upgrade{
-						Require:   domain.Product(pc.Upgrade.Product),
-						Increases: domain.Capacity(pc.Upgrade.Capacity),
-					},
-				}
+			ProducingRewards: lo.MapKeys(step.Rewards.Producing, func(_ float64, id domain.ProducerId) string {
+				return string(id)
 			}),
+			Score:    lo.ToPtr(int64(step.Score)),
+			Rejected: step.Rejected,
+			Done:     lo.ToPtr(step.Done),
+		})
+	})
+
+	api.ListSessionsHandler = operations.ListSessionsHandlerFunc(func(params operations.ListSessionsParams) middleware.Responder {
+		result := []*models.SessionInfo{}
+		for _, id := range sessions.List() {
+			// a session deleted meanwhile is skipped
+			if emulator, err := sessions.Get(id); err == nil {
+				result = append(result, sessionInfoModel(id, emulator))
+			}
 		}
+		return operations.NewListSessionsOK().WithPayload(result)
+	})
 
-		if err := config.Validate(); err != nil {
+	api.CreateSessionHandler = operations.CreateSessionHandlerFunc(func(params operations.CreateSessionParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		if params.Body.Seed < 0 {
+			return middleware.Error(http.StatusBadRequest, "seed must not be negative")
+		}
+		if params.Body.Config != nil && params.Body.Scenario != "" {
+			return middleware.Error(http.StatusBadRequest, "config and scenario are exclusive")
+		}
+		var sessionConfig *domain.Configuration
+		seed := uint64(params.Body.Seed)
+		var err error
+		switch {
+		case params.Body.Config != nil:
+			sessionConfig, err = configuration(params.Body.Config)
+		case params.Body.Scenario != "":
+			var scenario application.Scenario
+			if scenario, err = scenarios.Get(params.Body.Scenario); err == nil {
+				sessionConfig = scenario.Config
+				if seed == 0 && scenario.Seed != nil {
+					seed = *scenario.Seed
+				}
+			}
+		default:
+			sessionConfig, err = fileConfig()
+		}
+		if err != nil {
+			return middleware.Error(http.StatusBadRequest, err.Error())
+		}
+		id, emulator, err := sessions.Create(params.Body.ID, sessionConfig, seed)
+		if err != nil {
 			return middleware.Error(http.StatusBadRequest, err.Error())
 		}
+		return operations.NewCreateSessionOK().WithPayload(sessionInfoModel(id, emulator))
+	})
 
-		if err := emulator.UpdateConfig(config); err != nil {
+	api.ForkSessionHandler = operations.ForkSessionHandlerFunc(func(params operations.ForkSessionParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		var branchConfig *domain.Configuration
+		if params.Body.Config != nil {
+			var err error
+			if branchConfig, err = configuration(params.Body.Config); err != nil {
+				return middleware.Error(http.StatusBadRequest, err.Error())
+			}
+		}
+		cycle := lo.FromPtr(params.Body.Cycle)
+		if cycle < 1 {
+			return middleware.Error(http.StatusBadRequest, "cycle must be positive")
+		}
+		id, emulator, err := sessions.Fork(params.Body.ID, params.SessionID, uint(cycle), branchConfig)
+		if stderrors.Is(err, domain.ErrNotFound) {
+			return middleware.Error(http.StatusNotFound, err.Error())
+		}
+		if err != nil {
 			return middleware.Error(http.StatusBadRequest, err.Error())
 		}
+		return operations.NewForkSessionOK().WithPayload(sessionInfoModel(id, emulator))
+	})
 
-		return operations.NewUpdateConfigOK()
+	api.ListCheckpointsHandler = operations.ListCheckpointsHandlerFunc(func(params operations.ListCheckpointsParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		return operations.NewListCheckpointsOK().WithPayload(lo.Map(emulator.Checkpoints(), func(cycle uint, _ int) int64 {
+			return int64(cycle)
+		}))
+	})
+
+	api.RewindSessionHandler = operations.RewindSessionHandlerFunc(func(params operations.RewindSessionParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		err := emulator.Rewind(uint(max(0, lo.FromPtr(params.Body.Cycle))))
+		if stderrors.Is(err, domain.ErrNotFound) {
+			return middleware.Error(http.StatusNotFound, err.Error())
+		}
+		if err != nil {
+			return middleware.Error(http.StatusInternalServerError, err.Error())
+		}
+		info := emulator.GetSystemInfo()
+		return operations.NewRewindSessionOK().WithPayload(&info)
+	})
+
+	api.GetSessionHandler = operations.GetSessionHandlerFunc(func(params operations.GetSessionParams) middleware.Responder {
+		emulator, notFound := session(params.SessionID)
+		if notFound != nil {
+			return notFound
+		}
+		return operations.NewGetSessionOK().WithPayload(sessionInfoModel(params.SessionID, emulator))
+	})
+
+	api.DeleteSessionHandler = operations.DeleteSessionHandlerFunc(func(params operations.DeleteSessionParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		if err := sessions.Delete(params.SessionID); err != nil {
+			return middleware.Error(http.StatusNotFound, err.Error())
+		}
+		return operations.NewDeleteSessionOK()
+	})
+
+	// stored responds with an error unless the server has a store
+	stored := func() middleware.Responder {
+		if store == nil {
+			return middleware.Error(http.StatusNotFound, "the server has no store")
+		}
+		return nil
+	}
+	// runError responds with the error of the store
+	runError := func(err error) middleware.Responder {
+		if stderrors.Is(err, domain.ErrNotFound) {
+			return middleware.Error(http.StatusNotFound, err.Error())
+		}
+		return middleware.Error(http.StatusInternalServerError, err.Error())
+	}
+
+	api.ListRunsHandler = operations.ListRunsHandlerFunc(func(params operations.ListRunsParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		if r := stored(); r != nil {
+			return r
+		}
+		runs, err := store.Runs()
+		if err != nil {
+			return runError(err)
+		}
+		if params.SessionID != nil {
+			runs = lo.Filter(runs, func(run application.RunInfo, _ int) bool {
+				return run.SessionId == *params.SessionID
+			})
+		}
+		return operations.NewListRunsOK().WithPayload(lo.Map(runs, func(run application.RunInfo, _ int) *models.RunInfo {
+			return runInfoModel(run)
+		}))
+	})
+
+	api.GetRunHandler = operations.GetRunHandlerFunc(func(params operations.GetRunParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		if r := stored(); r != nil {
+			return r
+		}
+		run, err := store.Run(params.RunID)
+		if err != nil {
+			return runError(err)
+		}
+		return operations.NewGetRunOK().WithPayload(runInfoModel(run))
+	})
+
+	api.GetRunCyclesHandler = operations.GetRunCyclesHandlerFunc(func(params operations.GetRunCyclesParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		if r := stored(); r != nil {
+			return r
+		}
+		cycles, err := store.Cycles(params.RunID)
+		if err != nil {
+			return runError(err)
+		}
+		return operations.NewGetRunCyclesOK().WithPayload(lo.Map(cycles, func(c application.CycleRecord, _ int) models.CycleRecord {
+			return c
+		}))
+	})
+
+	api.GetRunEventsHandler = operations.GetRunEventsHandlerFunc(func(params operations.GetRunEventsParams, principal *models.Principal) middleware.Responder {
+		if r := forbidden(principal, admin); r != nil {
+			return r
+		}
+		if r := stored(); r != nil {
+			return r
+		}
+		events, err := store.Events(params.RunID)
+		if err != nil {
+			return runError(err)
+		}
+		return operations.NewGetRunEventsOK().WithPayload(lo.Map(events, func(e application.Event, _ int) *models.Event {
+			return eventModel(e)
+		}))
 	})
 
 	api.PreServerShutdown = func() {}
 
-	api.ServerShutdown = func() {}
+	api.ServerShutdown = func() {
+		if path := persistenceOptions.Snapshot; path != "" && defaultSession != nil {
+			if err := defaultSession.SaveSnapshot(path); err != nil {
+				log.Printf("Failed to save the default session: %v", err)
+			}
+		}
+		if commandLog != nil {
+			commandLog.Close()
+		}
+		// the sessions save their last events before the store is closed
+		sessions.Close()
+		if store != nil {
+			if err := store.Close(); err != nil {
+				log.Printf("Failed to close the store: %v", err)
+			}
+		}
+	}
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
@@ -267,3 +882,348 @@ func setupMiddlewares(handler http.Handler) http.Handler {
 func setupGlobalMiddleware(handler http.Handler) http.Handler {
 	return handler
 }
+
+// priceModel is nil for the undefined price
+func priceModel(price domain.CapacityUnitPrice) *float64 {
+	if price.IsNaN() {
+		return nil
+	}
+	return lo.ToPtr(float64(price))
+}
+
+func orderingAgentViewModel(view domain.OrderingAgentView) *models.OrderingAgentView {
+	return &models.OrderingAgentView{
+		lo.MapEntries(view.Incoming, func(oid domain.OrderId, val map[domain.CapacityType]domain.Capacity) (string, map[string]int64) {
+			return string(oid), lo.MapEntries(val, func(ct domain.CapacityType, cap domain.Capacity) (string, int64) {
+				return string(ct), int64(cap)
+			})
+		}),
+		lo.MapEntries(view.Producers, func(ct domain.CapacityType, val map[domain.ProducerId]domain.ProducerInfo) (string, map[string]models.ProducingAgentInfo) {
+			return string(ct), lo.MapEntries(val, func(pId domain.ProducerId, pInfo domain.ProducerInfo) (string, models.ProducingAgentInfo) {
+				return string(pId), models.ProducingAgentInfo{
+					int64(pInfo.Capacity),
+					string(pInfo.CapacityType),
+					priceModel(pInfo.CutOffPrice),
+					string(pInfo.Id),
+					int64(pInfo.MaxCapacity),
+				}
+			})
+		}),
+		lo.MapEntries(view.Tokens, func(oid domain.OrderId, tokens domain.Tokens) (string, int64) {
+			return string(oid), int64(tokens)
+		}),
+	}
+}
+
+func producingAgentViewModel(view domain.ProducingAgentView) *models.ProducingAgentView {
+	return &models.ProducingAgentView{
+		int64(view.Capacity),
+		int64(view.Degradation),
+		string(view.Id),
+		int64(view.MaxCapacity),
+		int64(view.RequestedCapacity),
+		int64(view.Restoration),
+		bool(view.RestorationRunning),
+		int64(view.Upgrade),
+		bool(view.UpgradeRunning),
+	}
+}
+
+func orderingAgentCommand(cmd *models.OrderingAgentCommand) domain.OrderingAgentCommand {
+	if cmd == nil {
+		return domain.OrderingAgentCommand{}
+	}
+	return domain.OrderingAgentCommand{
+		lo.MapEntries(cmd.Orders, func(orderId string, producers map[string]int64) (domain.OrderId, map[domain.ProducerId]domain.Tokens) {
+			return domain.OrderId(orderId), lo.MapEntries(producers, func(producerId string, tokens int64) (domain.ProducerId, domain.Tokens) {
+				return domain.ProducerId(producerId), domain.Tokens(tokens)
+			})
+		}),
+	}
+}
+
+func producingAgentCommand(cmd *models.ProducingAgentCommand) domain.ProducingAgentCommand {
+	if cmd == nil {
+		return domain.ProducingAgentCommand{}
+	}
+	return domain.ProducingAgentCommand{
+		cmd.DoRestoration,
+		cmd.DoUpgrade,
+	}
+}
+
+func gymObservationModel(observation gym.Observation) *models.GymObservation {
+	return &models.GymObservation{
+		Cycle: lo.ToPtr(int64(observation.Cycle)),
+		Phase: lo.ToPtr(string(observation.Phase)),
+		OrderingAgents: lo.Map(slices.Sorted(maps.Keys(observation.Ordering)), func(id domain.OrderingAgentId, _ int) *models.GymOrderingAgentView {
+			return &models.GymOrderingAgentView{
+				AgentID: lo.ToPtr(string(id)),
+				View:    orderingAgentViewModel(observation.Ordering[id]),
+			}
+		}),
+		ProducingAgents: lo.Map(slices.Sorted(maps.Keys(observation.Producing)), func(id domain.ProducerId, _ int) *models.ProducingAgentView {
+			return producingAgentViewModel(observation.Producing[id])
+		}),
+	}
+}
+
+func phaseDeadlineModel(d domain.PhaseDeadline) *models.PhaseDeadline {
+	return &models.PhaseDeadline{
+		TimeoutMs:    int64(d.TimeoutMs),
+		AllSubmitted: d.AllSubmitted,
+	}
+}
+
+func phaseDeadline(d *models.PhaseDeadline) domain.PhaseDeadline {
+	if d == nil {
+		return domain.PhaseDeadline{}
+	}
+	return domain.PhaseDeadline{
+		TimeoutMs:    uint(max(0, d.TimeoutMs)),
+		AllSubmitted: d.AllSubmitted,
+	}
+}
+
+func defaultActionModel(a domain.DefaultAction) *models.DefaultAction {
+	result := &models.DefaultAction{Kind: a.Kind}
+	if a.Strategy != nil {
+		result.Strategy = &models.StrategyConfig{
+			Name:   lo.ToPtr(a.Strategy.Name),
+			Params: a.Strategy.Params,
+		}
+	}
+	return result
+}
+
+func defaultAction(a *models.DefaultAction) domain.DefaultAction {
+	if a == nil {
+		return domain.DefaultAction{}
+	}
+	result := domain.DefaultAction{Kind: a.Kind}
+	if a.Strategy != nil {
+		result.Strategy = &domain.StrategyConfig{
+			Name:   lo.FromPtr(a.Strategy.Name),
+			Params: a.Strategy.Params,
+		}
+	}
+	return result
+}
+
+func configurationModel(config *domain.Configuration) *models.Configuration {
+	return &models.Configuration{
+		Version:       application.ConfigVersion,
+		CycleEmission: lo.ToPtr(int64(config.CycleEmission)),
+		ProcessSheets: lo.Map(config.ProcessSheets, func(ps domain.ProcessSheet, _ int) *models.ProcessSheet {
+			return &models.ProcessSheet{
+				Product: lo.ToPtr(int64(ps.Product)),
+				Require: lo.MapEntries(ps.Require, func(ct domain.CapacityType, cap domain.Capacity) (string, int64) {
+					return string(ct), int64(cap)
+				}),
+			}
+		}),
+		ProducerConfigs: lo.Map(config.ProducerConfigs, func(pc domain.ProducingAgentConfig, _ int) *models.ProducingAgentConfig {
+			return &models.ProducingAgentConfig{
+				ID:          lo.ToPtr(string(pc.Id)),
+				Type:        lo.ToPtr(string(pc.Type)),
+				Capacity:    lo.ToPtr(int64(pc.Capacity)),
+				Degradation: lo.ToPtr(int64(pc.Degradation)),
+				Restoration: &models.Restoration{
+					Require:  int64(pc.Restoration.Require),
+					Restores: int64(pc.Restoration.Restores),
+				},
+				Upgrade: &models.Upgrade{
+					Require:   int64(pc.Upgrade.Require),
+					Increases: int64(pc.Upgrade.Increases),
+				},
+			}
+		}),
+		Consumers: lo.Map(config.Consumers, func(cc domain.ConsumerConfig, _ int) *models.ConsumerConfig {
+			return &models.ConsumerConfig{
+				ID: lo.ToPtr(string(cc.Id)),
+				Products: lo.Map(cc.Products, func(p domain.Product, _ int) int64 {
+					return int64(p)
+				}),
+			}
+		}),
+		OrderingBots: lo.MapToSlice(config.OrderingBots, func(agentId domain.OrderingAgentId, sc domain.StrategyConfig) *models.OrderingBotConfig {
+			return &models.OrderingBotConfig{
+				AgentID: lo.ToPtr(string(agentId)),
+				Strategy: &models.StrategyConfig{
+					Name:   lo.ToPtr(sc.Name),
+					Params: sc.Params,
+				},
+			}
+		}),
+		ProducingBots: lo.MapToSlice(config.ProducingBots, func(producerId domain.ProducerId, sc domain.StrategyConfig) *models.ProducingBotConfig {
+			return &models.ProducingBotConfig{
+				ProducerID: lo.ToPtr(string(producerId)),
+				Strategy: &models.StrategyConfig{
+					Name:   lo.ToPtr(sc.Name),
+					Params: sc.Params,
+				},
+			}
+		}),
+		Deadlines: &models.Deadlines{
+			Investment:       phaseDeadlineModel(config.Deadlines.Investment),
+			Ordering:         phaseDeadlineModel(config.Deadlines.Ordering),
+			DefaultProducing: defaultActionModel(config.Deadlines.DefaultProducing),
+			DefaultOrdering:  defaultActionModel(config.Deadlines.DefaultOrdering),
+		},
+	}
+}
+
+// configuration converts and validates the model of the current version
+func configuration(m *models.Configuration) (*domain.Configuration, error) {
+	config, err := convertConfiguration(m)
+	if err != nil {
+		return nil, err
+	}
+	return config, config.Validate()
+}
+
+// convertConfiguration converts the model of the current version without validation, the investments are optional
+func convertConfiguration(m *models.Configuration) (*domain.Configuration, error) {
+	if m.Version != 0 && m.Version != application.ConfigVersion {
+		return nil, fmt.Errorf("config version %d is not supported by the API, the current version is %d", m.Version, application.ConfigVersion)
+	}
+	config := &domain.Configuration{
+		CycleEmission: domain.Tokens(lo.FromPtr(m.CycleEmission)),
+		ProcessSheets: lo.Map(m.ProcessSheets, func(ps *models.ProcessSheet, _ int) domain.ProcessSheet {
+			return domain.ProcessSheet{
+				Product: domain.Product(lo.FromPtr(ps.Product)),
+				Require: lo.MapEntries(ps.Require, func(ct string, cap int64) (domain.CapacityType, domain.Capacity) {
+					return domain.CapacityType(ct), domain.Capacity(cap)
+				}),
+			}
+		}),
+		ProducerConfigs: lo.Map(m.ProducerConfigs, func(pc *models.ProducingAgentConfig, _ int) domain.ProducingAgentConfig {
+			return domain.ProducingAgentConfig{
+				Id:          domain.ProducerId(lo.FromPtr(pc.ID)),
+				Type:        domain.CapacityType(lo.FromPtr(pc.Type)),
+				Capacity:    domain.Capacity(lo.FromPtr(pc.Capacity)),
+				Degradation: domain.DegradationRate(lo.FromPtr(pc.Degradation)),
+				Restoration: domain.Restoration{
+					Require:  domain.Product(lo.FromPtr(pc.Restoration).Require),
+					Restores: domain.Capacity(lo.FromPtr(pc.Restoration).Restores),
+				},
+				Upgrade: domain.Upgrade{
+					Require:   domain.Product(lo.FromPtr(pc.Upgrade).Require),
+					Increases: domain.Capacity(lo.FromPtr(pc.Upgrade).Increases),
+				},
+			}
+		}),
+		Consumers: lo.Map(m.Consumers, func(cc *models.ConsumerConfig, _ int) domain.ConsumerConfig {
+			return domain.ConsumerConfig{
+				Id: domain.ConsumerId(lo.FromPtr(cc.ID)),
+				Products: lo.Map(cc.Products, func(p int64, _ int) domain.Product {
+					return domain.Product(p)
+				}),
+			}
+		}),
+		OrderingBots: lo.SliceToMap(m.OrderingBots, func(bot *models.OrderingBotConfig) (domain.OrderingAgentId, domain.StrategyConfig) {
+			return domain.OrderingAgentId(lo.FromPtr(bot.AgentID)), domain.StrategyConfig{
+				Name:   lo.FromPtr(bot.Strategy.Name),
+				Params: bot.Strategy.Params,
+			}
+		}),
+		ProducingBots: lo.SliceToMap(m.ProducingBots, func(bot *models.ProducingBotConfig) (domain.ProducerId, domain.StrategyConfig) {
+			return domain.ProducerId(lo.FromPtr(bot.ProducerID)), domain.StrategyConfig{
+				Name:   lo.FromPtr(bot.Strategy.Name),
+				Params: bot.Strategy.Params,
+			}
+		}),
+	}
+	if d := m.Deadlines; d != nil {
+		config.Deadlines = domain.Deadlines{
+			Investment:       phaseDeadline(d.Investment),
+			Ordering:         phaseDeadline(d.Ordering),
+			DefaultProducing: defaultAction(d.DefaultProducing),
+			DefaultOrdering:  defaultAction(d.DefaultOrdering),
+		}
+	}
+	return config, nil
+}
+
+func configAnalysisModel(analysis domain.Analysis) *models.ConfigAnalysis {
+	findings := func(findings []domain.Finding) []*models.ConfigFinding {
+		return lo.Map(findings, func(f domain.Finding, _ int) *models.ConfigFinding {
+			return &models.ConfigFinding{Code: lo.ToPtr(f.Code), Subject: f.Subject, Message: lo.ToPtr(f.Message)}
+		})
+	}
+	return &models.ConfigAnalysis{
+		Errors:   findings(analysis.Errors),
+		Warnings: findings(analysis.Warnings),
+		Demand: lo.MapKeys(analysis.Demand, func(_ float64, ct domain.CapacityType) string {
+			return string(ct)
+		}),
+		Supply: lo.MapEntries(analysis.Supply, func(ct domain.CapacityType, cap domain.Capacity) (string, int64) {
+			return string(ct), int64(cap)
+		}),
+	}
+}
+
+// snapshot decodes the free-form snapshot model, its schema is application.Snapshot
+func snapshot(m models.Snapshot) (application.Snapshot, error) {
+	var result application.Snapshot
+	data, err := json.Marshal(m)
+	if err != nil {
+		return result, err
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("invalid snapshot: %w", err)
+	}
+	return result, nil
+}
+
+func scenarioModel(scenario application.Scenario) *models.Scenario {
+	result := &models.Scenario{
+		Name:        lo.ToPtr(scenario.Name),
+		Description: scenario.Description,
+		Difficulty:  scenario.Difficulty,
+		Expected:    scenario.Expected,
+	}
+	if scenario.Seed != nil {
+		result.Seed = lo.ToPtr(int64(*scenario.Seed))
+	}
+	if scenario.Config != nil {
+		result.Config = configurationModel(scenario.Config)
+	}
+	return result
+}
+
+// scenarioError is not found for the unknown scenarios, the invalid scenario files are the server's errors
+func scenarioError(err error) middleware.Responder {
+	if stderrors.Is(err, domain.ErrNotFound) {
+		return middleware.Error(http.StatusNotFound, err.Error())
+	}
+	return middleware.Error(http.StatusInternalServerError, err.Error())
+}
+
+func sessionInfoModel(id string, emulator *application.Emulator) *models.SessionInfo {
+	info := emulator.GetSystemInfo()
+	return &models.SessionInfo{
+		ID:           lo.ToPtr(id),
+		Seed:         lo.ToPtr(int64(emulator.Seed())),
+		CycleCounter: info.CycleCounter,
+		State:        info.State,
+		RunID:        emulator.RunId(),
+	}
+}
+
+func runInfoModel(run application.RunInfo) *models.RunInfo {
+	result := &models.RunInfo{
+		ID:        lo.ToPtr(run.Id),
+		SessionID: lo.ToPtr(run.SessionId),
+		Started:   run.Started.Format(time.RFC3339),
+		Seed:      int64(run.Seed),
+		FromCycle: int64(run.FromCycle),
+		Cycles:    int64(run.Cycles),
+		Score:     int64(run.Score),
+	}
+	// the list of the runs has no configurations
+	if run.Config != nil {
+		result.Config = configurationModel(run.Config)
+	}
+	return result
+}
@@ -0,0 +1,183 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"emulation/application"
+	"emulation/domain"
+	"emulation/restapi/operations"
+)
+
+func TestConfigurationModel(t *testing.T) {
+	t.Run(`Given the shipped configuration with bots and deadlines
+		When it is converted to the REST model and back
+		Then it is the same configuration`, func(t *testing.T) {
+
+		config, err := application.LoadConfig("../config.json")
+		require.NoError(t, err)
+		config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{
+			"p1": {Name: "proportional", Params: map[string]float64{"share": 0.5}},
+		}
+		config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{"p2": {Name: "never"}}
+		config.Deadlines = domain.Deadlines{
+			Investment:       domain.PhaseDeadline{TimeoutMs: 100, AllSubmitted: true},
+			DefaultOrdering:  domain.DefaultAction{Kind: domain.DefaultActionStrategy, Strategy: &domain.StrategyConfig{Name: "proportional"}},
+			DefaultProducing: domain.DefaultAction{Kind: domain.DefaultActionRepeat},
+		}
+
+		model := configurationModel(config)
+		require.Equal(t, int64(application.ConfigVersion), model.Version)
+		converted, err := configuration(model)
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(config, converted, cmpopts.EquateEmpty()))
+	})
+
+	t.Run(`Given a model of another version or without investments
+		When it is converted
+		Then the version is rejected and the investments are empty`, func(t *testing.T) {
+
+		config, err := application.LoadConfig("../config.json")
+		require.NoError(t, err)
+		model := configurationModel(config)
+
+		model.Version = 1
+		_, err = configuration(model)
+		require.ErrorContains(t, err, "version 1 is not supported")
+
+		model.Version = 0
+		for _, pc := range model.ProducerConfigs {
+			pc.Restoration, pc.Upgrade = nil, nil
+		}
+		converted, err := configuration(model)
+		require.NoError(t, err)
+		require.Equal(t, domain.Restoration{}, converted.ProducerConfigs[0].Restoration)
+		require.Equal(t, domain.Upgrade{}, converted.ProducerConfigs[0].Upgrade)
+	})
+}
+
+func TestOrderingAgentViewModel(t *testing.T) {
+	t.Run(`Given producers with a fractional and an undefined cut off price
+		When the ordering agent's view is converted to the REST model
+		Then the fractional price is kept and the undefined one is absent`, func(t *testing.T) {
+
+		model := orderingAgentViewModel(domain.OrderingAgentView{
+			Producers: map[domain.CapacityType]map[domain.ProducerId]domain.ProducerInfo{
+				"a": {
+					"p1": {Id: "p1", CapacityType: "a", Capacity: 10, MaxCapacity: 20, CutOffPrice: 2.5},
+					"p2": {Id: "p2", CapacityType: "a", Capacity: 10, MaxCapacity: 20, CutOffPrice: domain.UndefinedPrice},
+				},
+			},
+		})
+		require.Equal(t, lo.ToPtr(2.5), model.Producers["a"]["p1"].CutOffPrice)
+		require.Nil(t, model.Producers["a"]["p2"].CutOffPrice)
+	})
+}
+
+// testServer serves the API with the shipped configuration and the API keys of a team commanding p1 and of an admin
+func testServer(t *testing.T) *httptest.Server {
+	keys := filepath.Join(t.TempDir(), "api-keys.json")
+	require.NoError(t, os.WriteFile(keys, []byte(`[
+		{"key": "team", "name": "team", "orderingAgents": ["p1"], "producers": ["p1"]},
+		{"key": "admin", "name": "admin", "admin": true}
+	]`), 0o644))
+	authOptions.APIKeys, configOptions.Config = keys, "../config.json"
+	t.Cleanup(func() { authOptions.APIKeys, configOptions.Config = "", "config.json" })
+
+	spec, err := loads.Embedded(SwaggerJSON, FlatSwaggerJSON)
+	require.NoError(t, err)
+	server := httptest.NewServer(configureAPI(operations.NewTokenomicsAPI(spec)))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// status of the request with the API key, the response body isn't read
+func status(t *testing.T, server *httptest.Server, method, path, key string, body string) int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, server.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set("X-API-Key", key)
+	}
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestStreamEvents(t *testing.T) {
+	t.Run(`Given the server with API keys
+		When the events are streamed
+		Then a team watches only the agents it commands and only an admin watches all agents`, func(t *testing.T) {
+
+		server := testServer(t)
+		for _, c := range []struct {
+			path, key string
+			status    int
+		}{
+			{"/sessions/default/events?agentId=p1", "", http.StatusUnauthorized},
+			{"/sessions/default/events", "team", http.StatusForbidden},
+			{"/sessions/default/events?agentId=p1,p2", "team", http.StatusForbidden},
+			{"/sessions/default/events/ws", "team", http.StatusForbidden},
+			{"/sessions/default/events/ws?agentId=p2", "team", http.StatusForbidden},
+			{"/sessions/default/events?agentId=p1", "team", http.StatusOK},
+			{"/sessions/default/events", "admin", http.StatusOK},
+		} {
+			require.Equal(t, c.status, status(t, server, http.MethodGet, c.path, c.key, ""), "%s with key %q", c.path, c.key)
+		}
+	})
+}
+
+func TestRuns(t *testing.T) {
+	t.Run(`Given the server with API keys and no store
+		When the stored runs are requested
+		Then only an admin key passes to the store`, func(t *testing.T) {
+
+		server := testServer(t)
+		for _, c := range []struct {
+			path, key string
+			status    int
+		}{
+			{"/runs", "", http.StatusUnauthorized},
+			{"/runs", "team", http.StatusForbidden},
+			{"/runs/run-1", "team", http.StatusForbidden},
+			{"/runs/run-1/cycles", "team", http.StatusForbidden},
+			{"/runs/run-1/events", "team", http.StatusForbidden},
+			{"/runs", "admin", http.StatusNotFound},
+			{"/runs/run-1/events", "admin", http.StatusNotFound},
+		} {
+			require.Equal(t, c.status, status(t, server, http.MethodGet, c.path, c.key, ""), "%s with key %q", c.path, c.key)
+		}
+	})
+}
+
+func TestUpdateConfig(t *testing.T) {
+	t.Run(`Given the server with the shipped configuration
+		When a configuration is put with an unknown key of a producer's restoration
+		Then it is rejected
+		And the shipped configuration is accepted`, func(t *testing.T) {
+
+		server := testServer(t)
+		data, err := os.ReadFile("../config.json")
+		require.NoError(t, err)
+		config := string(data)
+		unknown := strings.Replace(config, `"restores"`, `"restore": 90, "restores"`, 1)
+		require.NotEqual(t, config, unknown)
+
+		require.Equal(t, http.StatusBadRequest, status(t, server, http.MethodPut, "/sessions/default/config", "admin", unknown))
+		require.Equal(t, http.StatusOK, status(t, server, http.MethodPut, "/sessions/default/config", "admin", config))
+	})
+}
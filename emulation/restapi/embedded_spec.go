@@ -35,22 +35,11 @@ func init() {
   "host": "localhost:8080",
   "basePath": "/",
   "paths": {
-    "/config": {
-      "get": {
-        "summary": "Get current system configuration",
-        "operationId": "getConfig",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/Configuration"
-            }
-          }
-        }
-      },
-      "put": {
-        "summary": "Update system configuration",
-        "operationId": "updateConfig",
+    "/config/analyze": {
+      "post": {
+        "description": "The analysis builds the dependency graph of the capacity types, the products and the investments. The errors make the configuration unplayable and are rejected by the other endpoints: structural problems, products without process sheets or with capacity types no producer provides. The warnings are unused products and producers, degradation faster than restoration, capacity types needing each other's capacity to be restored and the steady-state capacity deficits.",
+        "summary": "Analyse a configuration without applying it",
+        "operationId": "analyzeConfig",
         "parameters": [
           {
             "name": "body",
@@ -63,754 +52,4361 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Configuration updated successfully"
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigAnalysis"
+            }
           },
           "400": {
-            "description": "Invalid configuration"
+            "description": "Unsupported configuration version"
           }
         }
       }
     },
-    "/ordering-agents": {
+    "/runs": {
       "get": {
-        "summary": "Get ordering agents list",
-        "operationId": "listOrderingAgents",
+        "description": "A run is a game of a session from a reset, a restored snapshot, a rewind or a fork. The runs are kept in the store of the server and outlive its restarts. Returned without their configurations in the order of creation.",
+        "summary": "List the persisted runs",
+        "operationId": "listRuns",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Return the runs of the given session only",
+            "name": "sessionId",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/OrderingAgentInfo"
+                "$ref": "#/definitions/RunInfo"
               }
             }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "The server has no store"
           }
         }
       }
     },
-    "/ordering-agents/{id}": {
+    "/runs/{runId}": {
       "get": {
-        "description": "Retrieve the view of an ordering agent.",
-        "summary": "Get Ordering Agent View",
-        "operationId": "getOrderingAgentView",
+        "summary": "Get a persisted run with its configuration",
+        "operationId": "getRun",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
         "responses": {
           "200": {
-            "description": "Successful response",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/OrderingAgentView"
+              "$ref": "#/definitions/RunInfo"
             }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Run not found or the server has no store"
           }
         }
       },
-      "post": {
-        "description": "Send a command to an ordering agent.",
-        "summary": "Submit Ordering Agent Command",
-        "operationId": "sendOrderingAgentCommand",
-        "parameters": [
+      "parameters": [
+        {
+          "type": "string",
+          "name": "runId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/runs/{runId}/cycles": {
+      "get": {
+        "description": "Every cycle has its result and the market records of its auctions.",
+        "summary": "Get the completed cycles of a persisted run",
+        "operationId": "getRunCycles",
+        "security": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/OrderingAgentCommand"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CycleRecord"
+              }
             }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Run not found or the server has no store"
           }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "runId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/runs/{runId}/events": {
+      "get": {
+        "summary": "Get the events of a persisted run",
+        "operationId": "getRunEvents",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
         ],
         "responses": {
           "200": {
-            "description": "Command processed successfully"
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Event"
+              }
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Run not found or the server has no store"
           }
         }
       },
       "parameters": [
         {
           "type": "string",
-          "name": "id",
+          "name": "runId",
           "in": "path",
           "required": true
         }
       ]
     },
-    "/producer-agents": {
+    "/scenarios": {
       "get": {
-        "summary": "Get producing agents list",
-        "operationId": "listProducingAgents",
+        "description": "The scenarios are the JSON and YAML files of the scenarios directory, named by the file names without the extension. They are listed by name without their configurations, the invalid files are skipped.",
+        "summary": "List the scenarios of the library",
+        "operationId": "listScenarios",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/ProducingAgentInfo"
+                "$ref": "#/definitions/Scenario"
               }
             }
           }
         }
       }
     },
-    "/producing-agents/{id}": {
+    "/scenarios/{name}": {
       "get": {
-        "description": "Retrieve the view of a producing agent.",
-        "summary": "Get Producing Agent View",
-        "operationId": "getProducingAgentView",
+        "summary": "Get the scenario with its configuration",
+        "operationId": "getScenario",
         "responses": {
           "200": {
-            "description": "Successful response",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/ProducingAgentView"
+              "$ref": "#/definitions/Scenario"
+            }
+          },
+          "404": {
+            "description": "Scenario not found"
+          },
+          "500": {
+            "description": "Invalid scenario file"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions": {
+      "get": {
+        "summary": "List simulation sessions",
+        "operationId": "listSessions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SessionInfo"
+              }
             }
           }
         }
       },
       "post": {
-        "description": "Send a command to a producing agent.",
-        "summary": "Submit Producing Agent Command",
-        "operationId": "sendProducingAgentCommand",
+        "description": "A session is an independent simulation with its own configuration, seed and system. The configuration file of the server is taken when the request has none. The session \"default\" is created on the server start when the file is valid.",
+        "summary": "Create a simulation session",
+        "operationId": "createSession",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ProducingAgentCommand"
+              "$ref": "#/definitions/CreateSessionRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Command processed successfully"
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SessionInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid configuration, the session exists already or the request has no configuration and the file of the server is missing or invalid"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      }
+    },
+    "/sessions/{sessionId}": {
+      "get": {
+        "summary": "Get a simulation session",
+        "operationId": "getSession",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SessionInfo"
+            }
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Delete a simulation session",
+        "operationId": "deleteSession",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
       },
       "parameters": [
         {
           "type": "string",
-          "name": "id",
+          "name": "sessionId",
           "in": "path",
           "required": true
         }
       ]
     },
-    "/system": {
+    "/sessions/{sessionId}/checkpoints": {
       "get": {
-        "summary": "Get system state",
-        "operationId": "getSystemInfo",
+        "description": "A checkpoint is the state at the start of a cycle, after the bots' investments. Only the checkpoints of the last 100 cycles are kept. The checkpoints start over on a reset or a restored snapshot.",
+        "summary": "List the cycles the session can be rewound to",
+        "operationId": "listCheckpoints",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/SystemInfo"
+                "type": "integer"
               }
             }
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
       },
-      "delete": {
-        "tags": [
-          "Tokenomics"
-        ],
-        "summary": "Reset emulation state",
-        "operationId": "resetSystem",
-        "responses": {
-          "200": {
-            "description": "OK"
-          }
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "/system/complete-cycle": {
-      "post": {
-        "tags": [
-          "Tokenomics"
-        ],
-        "summary": "Complete current cycle",
-        "operationId": "completeCycle",
+    "/sessions/{sessionId}/config": {
+      "get": {
+        "summary": "Get current system configuration",
+        "operationId": "getConfig",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/CycleResult"
+              "$ref": "#/definitions/Configuration"
             }
           }
         }
-      }
-    },
-    "/system/start-ordering": {
-      "post": {
-        "tags": [
-          "Tokenomics"
+      },
+      "put": {
+        "description": "The configuration takes effect on the next reset, or at once when applied. An invalid configuration, including unknown keys, bot strategies and default actions, is rejected and the current one is kept.",
+        "summary": "Update system configuration",
+        "operationId": "updateConfig",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Configuration"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Reset the simulation with the configuration at once",
+            "name": "apply",
+            "in": "query"
+          }
         ],
-        "summary": "Complete investment phase",
-        "operationId": "startOrdering",
         "responses": {
           "200": {
-            "description": "OK"
+            "description": "Configuration updated successfully"
+          },
+          "400": {
+            "description": "Invalid configuration"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "Configuration": {
-      "type": "object",
-      "required": [
-        "cycleEmission",
-        "processSheets",
-        "producerConfigs"
-      ],
-      "properties": {
-        "cycleEmission": {
-          "description": "Amount of tokens emitted each cycle",
-          "type": "integer"
-        },
-        "processSheets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ProcessSheet"
-          }
-        },
-        "producerConfigs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ProducingAgentConfig"
+      },
+      "delete": {
+        "description": "The file is read again, so its edits are taken. The configuration takes effect on the next reset, or at once when applied.",
+        "summary": "Revert to the configuration file of the server",
+        "operationId": "revertConfig",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Reset the simulation with the configuration at once",
+            "name": "apply",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The configuration of the file",
+            "schema": {
+              "$ref": "#/definitions/Configuration"
+            }
+          },
+          "400": {
+            "description": "The configuration file is missing or invalid"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
-      }
-    },
-    "CycleResult": {
-      "type": "object",
-      "required": [
-        "score"
-      ],
-      "properties": {
-        "score": {
-          "type": "integer"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "OrderingAgentCommand": {
-      "description": "Ordering agent command",
-      "type": "object",
-      "properties": {
-        "orders": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "object",
-            "additionalProperties": {
-              "type": "integer"
+    "/sessions/{sessionId}/config/analyze": {
+      "get": {
+        "description": "See POST /config/analyze.",
+        "summary": "Analyse the configuration of the session",
+        "operationId": "analyzeSessionConfig",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigAnalysis"
             }
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
       },
-      "example": {
-        "orders": {
-          "order-1": {
-            "producer-1": 110,
-            "producer-2": 350
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/events": {
+      "get": {
+        "description": "Server-sent events: every event is sent with its type as the event name and the Event JSON as the data. Types: phase.changed, orders.created, bid.placed, investment.requested, investment.completed, investment.rejected, production, cycle.completed.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "summary": "Stream the events of the session",
+        "operationId": "streamEvents",
+        "security": [
+          {
+            "apiKey": []
           },
-          "order-2": {
-            "producer-2": 10,
-            "producer-3": 50
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Comma separated ids of the agents to stream the events of, the system-wide events are always streamed. Required unless the API is open or the key is admin, the agents must be commanded by the key",
+            "name": "agentId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of events",
+            "schema": {
+              "$ref": "#/definitions/Event"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key may not watch all the agents of the filter, only an admin key may watch all agents"
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
-      }
-    },
-    "OrderingAgentInfo": {
-      "type": "object",
-      "properties": {
-        "id": {
-          "description": "Agent ID",
-          "type": "string"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "OrderingAgentView": {
-      "description": "Ordering agent view",
-      "type": "object",
-      "properties": {
-        "incoming": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "object",
-            "additionalProperties": {
-              "type": "integer"
-            }
+    "/sessions/{sessionId}/events/ws": {
+      "get": {
+        "description": "The connection is upgraded to WebSocket, every message is an Event JSON.",
+        "produces": [
+          "application/json"
+        ],
+        "summary": "Stream the events of the session over WebSocket",
+        "operationId": "streamEventsWebSocket",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Comma separated ids of the agents to stream the events of, the system-wide events are always streamed. Required unless the API is open or the key is admin, the agents must be commanded by the key",
+            "name": "agentId",
+            "in": "query"
           }
-        },
-        "producers": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "object",
-            "additionalProperties": {
-              "$ref": "#/definitions/ProducingAgentInfo"
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of events",
+            "schema": {
+              "$ref": "#/definitions/Event"
             }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key may not watch all the agents of the filter, only an admin key may watch all agents"
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
       },
-      "example": {
-        "incoming": {
-          "order-1": {
-            "capacity-1": 110,
-            "capacity-2": 350
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/fork": {
+      "post": {
+        "description": "The branch starts at the checkpoint of the cycle with the configuration of the request, or of the session when the request has none. The configuration must have the same agents and consumers. The session stays intact.",
+        "summary": "Fork a branch session from a cycle of the session",
+        "operationId": "forkSession",
+        "security": [
+          {
+            "apiKey": []
           },
-          "order-2": {
-            "capacity-3": 55
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ForkRequest"
+            }
           }
-        },
-        "producers": {
-          "capacity-1": {
-            "producer-1": {
-              "capacity": 120,
-              "degradation": 5,
-              "id": "producer-1",
-              "maxCapacity": 150,
-              "requestedCapacity": 500,
-              "restoration": 20,
-              "restorationRunning": "false",
-              "upgrade": 10,
-              "upgradeRunning": "true"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SessionInfo"
             }
+          },
+          "400": {
+            "description": "Invalid configuration or the branch session exists already"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session or checkpoint not found"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "ProcessSheet": {
-      "type": "object",
-      "required": [
-        "product",
-        "require"
-      ],
-      "properties": {
-        "product": {
-          "description": "Product identifier",
-          "type": "integer"
-        },
-        "require": {
-          "description": "Map of capacity type to required capacity",
-          "type": "object",
-          "additionalProperties": {
-            "type": "integer"
+    "/sessions/{sessionId}/gym/reset": {
+      "post": {
+        "description": "The environment plays an own copy of the current configuration, the agents marked as bots are driven by their strategies. Every step plays a single phase of a cycle: the investment phase for the producing agents, then the ordering phase for the ordering agents.",
+        "summary": "Start a new episode of the step environment",
+        "operationId": "resetGym",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GymResetRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Observation of the first phase",
+            "schema": {
+              "$ref": "#/definitions/GymObservation"
+            }
+          },
+          "400": {
+            "description": "Invalid request or configuration"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
           }
         }
-      }
-    },
-    "ProducingAgentCommand": {
-      "type": "object",
-      "properties": {
-        "doRestoration": {
-          "description": "Pass true for purchasing of Restoration (Not allowed if Restoration is producing)",
-          "type": "boolean"
-        },
-        "doUpgrade": {
-          "description": "Pass true for purchasing of Upgrade (Not allowed if Upgrade is producing)",
-          "type": "boolean"
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "ProducingAgentConfig": {
-      "type": "object",
-      "required": [
-        "id",
-        "type",
-        "capacity",
-        "degradation"
-      ],
-      "properties": {
-        "capacity": {
-          "description": "Initial capacity",
-          "type": "integer"
-        },
-        "degradation": {
-          "description": "Degradation rate",
-          "type": "integer"
-        },
-        "id": {
-          "description": "Producer identifier",
-          "type": "string"
+    "/sessions/{sessionId}/gym/step": {
+      "post": {
+        "summary": "Play the current phase with the actions of its agents",
+        "operationId": "stepGym",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GymActions"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GymStepResult"
+            }
+          },
+          "400": {
+            "description": "Invalid actions or the episode is done"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/market/history": {
+      "get": {
+        "description": "Retrieve per cycle auction results of the producers: cut off prices, capacity utilisation and funds.",
+        "summary": "Get market history",
+        "operationId": "getMarketHistory",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "First cycle to include",
+            "name": "fromCycle",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Last cycle to include",
+            "name": "toCycle",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return records of the given producer only",
+            "name": "producerId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/MarketRecord"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/ordering-agents": {
+      "get": {
+        "summary": "Get ordering agents list",
+        "operationId": "listOrderingAgents",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/OrderingAgentInfo"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/ordering-agents/{id}": {
+      "get": {
+        "description": "Retrieve the view of an ordering agent.",
+        "summary": "Get Ordering Agent View",
+        "operationId": "getOrderingAgentView",
+        "responses": {
+          "200": {
+            "description": "Successful response",
+            "schema": {
+              "$ref": "#/definitions/OrderingAgentView"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Send a command to an ordering agent.",
+        "summary": "Submit Ordering Agent Command",
+        "operationId": "sendOrderingAgentCommand",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OrderingAgentCommand"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Command processed successfully"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key is not bound to the agent"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         },
-        "restoration": {
-          "$ref": "#/definitions/Restoration"
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/ordering-agents/{id}/pass": {
+      "post": {
+        "description": "An ordering agent ends its turn in the current phase without a command, so the lockstep mode doesn't wait for it.",
+        "summary": "Pass the turn of an ordering agent",
+        "operationId": "passOrderingAgent",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Wrong phase or the turn is over already"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key is not bound to the agent"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         },
-        "type": {
-          "description": "Capacity type",
-          "type": "string"
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/producer-agents": {
+      "get": {
+        "summary": "Get producing agents list",
+        "operationId": "listProducingAgents",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ProducingAgentInfo"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/producing-agents/{id}": {
+      "get": {
+        "description": "Retrieve the view of a producing agent.",
+        "summary": "Get Producing Agent View",
+        "operationId": "getProducingAgentView",
+        "responses": {
+          "200": {
+            "description": "Successful response",
+            "schema": {
+              "$ref": "#/definitions/ProducingAgentView"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Send a command to a producing agent.",
+        "summary": "Submit Producing Agent Command",
+        "operationId": "sendProducingAgentCommand",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ProducingAgentCommand"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Command processed successfully"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key is not bound to the agent"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         },
-        "upgrade": {
-          "$ref": "#/definitions/Upgrade"
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/producing-agents/{id}/pass": {
+      "post": {
+        "description": "A producing agent ends its turn in the current phase without a command, so the lockstep mode doesn't wait for it.",
+        "summary": "Pass the turn of a producing agent",
+        "operationId": "passProducingAgent",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Wrong phase or the turn is over already"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key is not bound to the agent"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/rewind": {
+      "post": {
+        "description": "The later cycles and their checkpoints are dropped.",
+        "summary": "Rewind the session to the start of a cycle",
+        "operationId": "rewindSession",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RewindRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SystemInfo"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session or checkpoint not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/scenario": {
+      "post": {
+        "description": "The simulation starts over with the configuration of the scenario, and with its seed when it has one.",
+        "summary": "Reset the session into a scenario",
+        "operationId": "loadScenario",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoadScenarioRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SessionInfo"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session or scenario not found"
+          },
+          "500": {
+            "description": "Invalid scenario file"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system": {
+      "get": {
+        "summary": "Get system state",
+        "operationId": "getSystemInfo",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SystemInfo"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Reset emulation state",
+        "operationId": "resetSystem",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/commands": {
+      "get": {
+        "description": "Append-only log of the accepted commands: resets with the configuration and the seed, restores, rewinds, producing and ordering agent commands and phase transitions with the cycle results. The server keeps the commands of the current game since its last reset or restore. The log is replayed by tokenomics-replay.",
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Get the command log",
+        "operationId": "getCommandLog",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Number of the first returned command, the log starts with 1",
+            "name": "fromSeq",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Command"
+              }
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/complete-cycle": {
+      "post": {
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Complete current cycle",
+        "operationId": "completeCycle",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/CycleResult"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/missed-deadlines": {
+      "get": {
+        "description": "Numbers of the phase deadlines missed by the agents since the last reset. An agent misses a deadline when it hasn't sent its command by the end of the phase, the configured default action is taken for it then.",
+        "summary": "Get missed phase deadlines",
+        "operationId": "getMissedDeadlines",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/MissedDeadlines"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/snapshot": {
+      "get": {
+        "description": "The snapshot has the configuration, the seed, the producers with their bookings and running investments, the orders with their part statuses, the order id generator, the cycle counter and the missed deadlines.",
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Export the full state of the simulation",
+        "operationId": "exportSnapshot",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Snapshot"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "put": {
+        "description": "The session takes the configuration and the seed of the snapshot and goes on from its phase. The bots start over, the state of their strategies isn't kept.",
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Restore the simulation from a snapshot",
+        "operationId": "importSnapshot",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Snapshot"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Snapshot restored"
+          },
+          "400": {
+            "description": "Invalid snapshot"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/start-ordering": {
+      "post": {
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Complete investment phase",
+        "operationId": "startOrdering",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/wait": {
+      "get": {
+        "description": "Long polling for the agents playing without a controller: the response is sent as soon as the system leaves the known phase, or with the unchanged phase on the timeout.",
+        "summary": "Wait for the phase change",
+        "operationId": "waitPhase",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Cycle counter of the known phase",
+            "name": "cycleCounter",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "State of the known phase: OrdersPlacement or Ordering",
+            "name": "state",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Timeout of the wait, 30 seconds by default and 5 minutes at most",
+            "name": "timeoutMs",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Current phase",
+            "schema": {
+              "$ref": "#/definitions/SystemInfo"
+            }
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "Command": {
+      "description": "Accepted command of the command log, see application.Command",
+      "type": "object"
+    },
+    "ConfigAnalysis": {
+      "type": "object",
+      "required": [
+        "errors",
+        "warnings"
+      ],
+      "properties": {
+        "demand": {
+          "description": "Steady-state demand per cycle by capacity type: the consumers' orders and the restorations making up for the degradation",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigFinding"
+          }
+        },
+        "supply": {
+          "description": "Initial capacity of the producers by capacity type",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        },
+        "warnings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigFinding"
+          }
+        }
+      }
+    },
+    "ConfigFinding": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "invalid, unknown-product, unreachable-product, unused-product, unused-capacity, investment-cycle, no-restoration, restoration-deficit or capacity-deficit",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "subject": {
+          "description": "Product, capacity type or agent of the finding",
+          "type": "string"
+        }
+      }
+    },
+    "Configuration": {
+      "type": "object",
+      "required": [
+        "cycleEmission",
+        "processSheets",
+        "producerConfigs"
+      ],
+      "properties": {
+        "consumers": {
+          "description": "Final consumers randomly ordering the listed products",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConsumerConfig"
+          }
+        },
+        "cycleEmission": {
+          "description": "Amount of tokens emitted each cycle",
+          "type": "integer"
+        },
+        "deadlines": {
+          "$ref": "#/definitions/Deadlines"
+        },
+        "orderingBots": {
+          "description": "Ordering agents controlled by built-in strategies",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/OrderingBotConfig"
+          }
+        },
+        "processSheets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProcessSheet"
+          }
+        },
+        "producerConfigs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProducingAgentConfig"
+          }
+        },
+        "producingBots": {
+          "description": "Producing agents controlled by built-in strategies",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProducingBotConfig"
+          }
+        },
+        "version": {
+          "description": "Schema version of the configuration, the current one when absent. The API takes the current version only, the older files are migrated by the server on loading.",
+          "type": "integer"
+        }
+      },
+      "additionalProperties": false
+    },
+    "ConsumerConfig": {
+      "type": "object",
+      "required": [
+        "id",
+        "products"
+      ],
+      "properties": {
+        "id": {
+          "description": "Consumer identifier",
+          "type": "string"
+        },
+        "products": {
+          "description": "Products the consumer orders",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        }
+      },
+      "additionalProperties": false
+    },
+    "CreateSessionRequest": {
+      "type": "object",
+      "properties": {
+        "config": {
+          "$ref": "#/definitions/Configuration"
+        },
+        "id": {
+          "description": "Session id, generated when empty",
+          "type": "string"
+        },
+        "scenario": {
+          "description": "Scenario of the library the session starts with, instead of the config",
+          "type": "string"
+        },
+        "seed": {
+          "description": "Seed of the consumers' demand, of the scenario when zero and the scenario has one",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        }
+      }
+    },
+    "CycleRecord": {
+      "description": "Completed cycle of a run with its result and market records, see application.CycleRecord",
+      "type": "object"
+    },
+    "CycleResult": {
+      "type": "object",
+      "required": [
+        "score"
+      ],
+      "properties": {
+        "score": {
+          "type": "integer"
+        }
+      }
+    },
+    "Deadlines": {
+      "description": "Deadlines of the investment phase (producing agents) and the ordering phase (ordering agents)",
+      "type": "object",
+      "properties": {
+        "defaultOrdering": {
+          "$ref": "#/definitions/DefaultAction"
+        },
+        "defaultProducing": {
+          "$ref": "#/definitions/DefaultAction"
+        },
+        "investment": {
+          "$ref": "#/definitions/PhaseDeadline"
+        },
+        "ordering": {
+          "$ref": "#/definitions/PhaseDeadline"
+        }
+      },
+      "additionalProperties": false
+    },
+    "DefaultAction": {
+      "description": "Action taken for an agent which missed the deadline",
+      "type": "object",
+      "properties": {
+        "kind": {
+          "description": "abstain (the default), repeat (the last command) or strategy",
+          "type": "string"
+        },
+        "strategy": {
+          "$ref": "#/definitions/StrategyConfig"
+        }
+      },
+      "additionalProperties": false
+    },
+    "Event": {
+      "type": "object",
+      "required": [
+        "seq",
+        "type",
+        "cycle"
+      ],
+      "properties": {
+        "agentId": {
+          "description": "Agent of the event, empty for the system-wide events",
+          "type": "string"
+        },
+        "cycle": {
+          "type": "integer"
+        },
+        "data": {
+          "description": "Payload of the event type",
+          "type": "object"
+        },
+        "seq": {
+          "description": "Number of the event in the session",
+          "type": "integer"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "ForkRequest": {
+      "type": "object",
+      "required": [
+        "cycle"
+      ],
+      "properties": {
+        "config": {
+          "$ref": "#/definitions/Configuration"
+        },
+        "cycle": {
+          "description": "Cycle the branch starts at",
+          "type": "integer",
+          "minimum": 1
+        },
+        "id": {
+          "description": "Branch session id, generated when empty",
+          "type": "string"
+        }
+      }
+    },
+    "GymActions": {
+      "description": "Commands of the agents acting in the current phase, an agent without a command stays idle",
+      "type": "object",
+      "properties": {
+        "ordering": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GymOrderingAction"
+          }
+        },
+        "producing": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GymProducingAction"
+          }
+        }
+      }
+    },
+    "GymObservation": {
+      "type": "object",
+      "required": [
+        "cycle",
+        "phase"
+      ],
+      "properties": {
+        "cycle": {
+          "type": "integer"
+        },
+        "orderingAgents": {
+          "description": "Views of the ordering agents in the ordering phase",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GymOrderingAgentView"
+          }
+        },
+        "phase": {
+          "description": "investment or ordering",
+          "type": "string"
+        },
+        "producingAgents": {
+          "description": "Views of the producing agents in the investment phase",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProducingAgentView"
+          }
+        }
+      }
+    },
+    "GymOrderingAction": {
+      "type": "object",
+      "required": [
+        "agentId",
+        "command"
+      ],
+      "properties": {
+        "agentId": {
+          "type": "string"
+        },
+        "command": {
+          "$ref": "#/definitions/OrderingAgentCommand"
+        }
+      }
+    },
+    "GymOrderingAgentView": {
+      "type": "object",
+      "required": [
+        "agentId",
+        "view"
+      ],
+      "properties": {
+        "agentId": {
+          "type": "string"
+        },
+        "view": {
+          "$ref": "#/definitions/OrderingAgentView"
+        }
+      }
+    },
+    "GymProducingAction": {
+      "type": "object",
+      "required": [
+        "producerId",
+        "command"
+      ],
+      "properties": {
+        "command": {
+          "$ref": "#/definitions/ProducingAgentCommand"
+        },
+        "producerId": {
+          "type": "string"
+        }
+      }
+    },
+    "GymResetRequest": {
+      "type": "object",
+      "required": [
+        "cycles"
+      ],
+      "properties": {
+        "cycles": {
+          "description": "Number of cycles in the episode",
+          "type": "integer"
+        },
+        "seed": {
+          "description": "Seed of the consumers' demand",
+          "type": "integer"
+        }
+      }
+    },
+    "GymStepResult": {
+      "type": "object",
+      "required": [
+        "observation",
+        "score",
+        "done"
+      ],
+      "properties": {
+        "done": {
+          "type": "boolean"
+        },
+        "observation": {
+          "$ref": "#/definitions/GymObservation"
+        },
+        "orderingRewards": {
+          "description": "Negated score of the agent's orders in the completed cycle",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        },
+        "producingRewards": {
+          "description": "Tokens collected by the producer's auction in the completed cycle",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        },
+        "rejected": {
+          "description": "Errors of the commands refused by the system by agent id, such agents stay idle",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "score": {
+          "description": "Score of the completed cycle, zero after the investment phase",
+          "type": "integer"
+        }
+      }
+    },
+    "LoadScenarioRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "MarketRecord": {
+      "type": "object",
+      "properties": {
+        "acceptedCapacity": {
+          "description": "Capacity of the accepted bids",
+          "type": "integer"
+        },
+        "availableCapacity": {
+          "description": "Producer capacity at the start of the production",
+          "type": "integer"
+        },
+        "capacityType": {
+          "description": "Capacity type",
+          "type": "string"
+        },
+        "cutOffPrice": {
+          "description": "The cut off price of the cycle. Absent if no bid was accepted",
+          "type": "number",
+          "x-nullable": true
+        },
+        "cycle": {
+          "description": "Cycle number",
+          "type": "integer"
+        },
+        "funds": {
+          "description": "Tokens collected from the accepted bids",
+          "type": "integer"
+        },
+        "producerId": {
+          "description": "Producer ID",
+          "type": "string"
+        },
+        "rejectedCapacity": {
+          "description": "Capacity of the rejected bids",
+          "type": "integer"
+        },
+        "requestedCapacity": {
+          "description": "Total capacity requested by the bids",
+          "type": "integer"
+        }
+      }
+    },
+    "MissedDeadlines": {
+      "type": "object",
+      "required": [
+        "agentId",
+        "phase",
+        "missed"
+      ],
+      "properties": {
+        "agentId": {
+          "type": "string"
+        },
+        "missed": {
+          "type": "integer"
+        },
+        "phase": {
+          "description": "investment or ordering",
+          "type": "string"
+        }
+      }
+    },
+    "OrderingAgentCommand": {
+      "description": "Ordering agent command",
+      "type": "object",
+      "properties": {
+        "orders": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "object",
+            "additionalProperties": {
+              "type": "integer"
+            }
+          }
+        }
+      },
+      "example": {
+        "orders": {
+          "order-1": {
+            "producer-1": 110,
+            "producer-2": 350
+          },
+          "order-2": {
+            "producer-2": 10,
+            "producer-3": 50
+          }
+        }
+      }
+    },
+    "OrderingAgentInfo": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "Agent ID",
+          "type": "string"
+        }
+      }
+    },
+    "OrderingAgentView": {
+      "description": "Ordering agent view",
+      "type": "object",
+      "properties": {
+        "incoming": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "object",
+            "additionalProperties": {
+              "type": "integer"
+            }
+          }
+        },
+        "producers": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "object",
+            "additionalProperties": {
+              "$ref": "#/definitions/ProducingAgentInfo"
+            }
+          }
+        },
+        "tokens": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        }
+      },
+      "example": {
+        "incoming": {
+          "order-1": {
+            "capacity-1": 110,
+            "capacity-2": 350
+          },
+          "order-2": {
+            "capacity-3": 55
+          }
+        },
+        "producers": {
+          "capacity-1": {
+            "producer-1": {
+              "capacity": 120,
+              "degradation": 5,
+              "id": "producer-1",
+              "maxCapacity": 150,
+              "requestedCapacity": 500,
+              "restoration": 20,
+              "restorationRunning": "false",
+              "upgrade": 10,
+              "upgradeRunning": "true"
+            }
+          }
+        },
+        "tokens": {
+          "order-1": 400,
+          "order-2": 60
+        }
+      }
+    },
+    "OrderingBotConfig": {
+      "type": "object",
+      "required": [
+        "agentId",
+        "strategy"
+      ],
+      "properties": {
+        "agentId": {
+          "description": "Ordering agent identifier",
+          "type": "string"
+        },
+        "strategy": {
+          "$ref": "#/definitions/StrategyConfig"
+        }
+      },
+      "additionalProperties": false
+    },
+    "PhaseDeadline": {
+      "description": "A phase without a deadline ends only when the next phase is started explicitly",
+      "type": "object",
+      "properties": {
+        "allSubmitted": {
+          "description": "End the phase as soon as the last pending agent has submitted its command",
+          "type": "boolean"
+        },
+        "timeoutMs": {
+          "description": "Wall-clock timeout of the phase in milliseconds",
+          "type": "integer"
+        }
+      },
+      "additionalProperties": false
+    },
+    "ProcessSheet": {
+      "type": "object",
+      "required": [
+        "product",
+        "require"
+      ],
+      "properties": {
+        "product": {
+          "description": "Product identifier",
+          "type": "integer"
+        },
+        "require": {
+          "description": "Map of capacity type to required capacity",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        }
+      },
+      "additionalProperties": false
+    },
+    "ProducingAgentCommand": {
+      "type": "object",
+      "properties": {
+        "doRestoration": {
+          "description": "Pass true for purchasing of Restoration (Not allowed if Restoration is producing)",
+          "type": "boolean"
+        },
+        "doUpgrade": {
+          "description": "Pass true for purchasing of Upgrade (Not allowed if Upgrade is producing)",
+          "type": "boolean"
+        }
+      }
+    },
+    "ProducingAgentConfig": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "capacity",
+        "degradation"
+      ],
+      "properties": {
+        "capacity": {
+          "description": "Initial capacity",
+          "type": "integer"
+        },
+        "degradation": {
+          "description": "Degradation rate",
+          "type": "integer"
+        },
+        "id": {
+          "description": "Producer identifier",
+          "type": "string"
+        },
+        "restoration": {
+          "$ref": "#/definitions/Restoration"
+        },
+        "type": {
+          "description": "Capacity type",
+          "type": "string"
+        },
+        "upgrade": {
+          "$ref": "#/definitions/Upgrade"
+        }
+      },
+      "additionalProperties": false
+    },
+    "ProducingAgentInfo": {
+      "type": "object",
+      "properties": {
+        "capacity": {
+          "description": "Current capacity value",
+          "type": "integer"
+        },
+        "capacityType": {
+          "description": "Capacity type",
+          "type": "string"
+        },
+        "cutOffPrice": {
+          "description": "The cut off price in the previous cycle. Absent before the first accepted bid",
+          "type": "number",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Agent ID",
+          "type": "string"
+        },
+        "maxCapacity": {
+          "description": "Maximum capacity value",
+          "type": "integer"
+        }
+      }
+    },
+    "ProducingAgentView": {
+      "type": "object",
+      "properties": {
+        "capacity": {
+          "description": "Current capacity. Degradates each turn. Could be increased to MaxCapacity with Restoration purchase",
+          "type": "integer"
+        },
+        "degradation": {
+          "description": "Capacity decrease in the current cycle",
+          "type": "integer"
+        },
+        "id": {
+          "description": "Agent ID",
+          "type": "string"
+        },
+        "maxCapacity": {
+          "description": "Maximum capacity. Can be increased with Upgrade purchase",
+          "type": "integer"
+        },
+        "requestedCapacity": {
+          "description": "Total capacity was requested in the previous cycle",
+          "type": "integer"
+        },
+        "restoration": {
+          "description": "Capacity gain with Restoration",
+          "type": "integer"
+        },
+        "restorationRunning": {
+          "description": "Indicates Restoration production is running",
+          "type": "boolean"
+        },
+        "upgrade": {
+          "description": "MaxCapacity and Capacity gain with Upgrade",
+          "type": "integer"
+        },
+        "upgradeRunning": {
+          "description": "Indicates Upgrade production is running",
+          "type": "boolean"
+        }
+      }
+    },
+    "ProducingBotConfig": {
+      "type": "object",
+      "required": [
+        "producerId",
+        "strategy"
+      ],
+      "properties": {
+        "producerId": {
+          "description": "Producer identifier",
+          "type": "string"
+        },
+        "strategy": {
+          "$ref": "#/definitions/StrategyConfig"
+        }
+      },
+      "additionalProperties": false
+    },
+    "Restoration": {
+      "type": "object",
+      "properties": {
+        "require": {
+          "description": "Product required for restoration",
+          "type": "integer"
+        },
+        "restores": {
+          "description": "Capacity restored after restoration, up to the maximal capacity",
+          "type": "integer"
+        }
+      },
+      "additionalProperties": false
+    },
+    "RewindRequest": {
+      "type": "object",
+      "required": [
+        "cycle"
+      ],
+      "properties": {
+        "cycle": {
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "RunInfo": {
+      "type": "object",
+      "required": [
+        "id",
+        "sessionId"
+      ],
+      "properties": {
+        "config": {
+          "$ref": "#/definitions/Configuration"
+        },
+        "cycles": {
+          "description": "Number of the completed cycles",
+          "type": "integer"
+        },
+        "fromCycle": {
+          "description": "First cycle of the run, later than 1 for the restored games",
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "score": {
+          "description": "Total score of the completed cycles",
+          "type": "integer"
+        },
+        "seed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sessionId": {
+          "type": "string"
+        },
+        "started": {
+          "description": "Start time in RFC 3339",
+          "type": "string"
+        }
+      }
+    },
+    "Scenario": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "config": {
+          "$ref": "#/definitions/Configuration"
+        },
+        "description": {
+          "type": "string"
+        },
+        "difficulty": {
+          "description": "Free-form, e.g. easy, medium or hard",
+          "type": "string"
+        },
+        "expected": {
+          "description": "Behaviour the scenario is meant to show",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "seed": {
+          "description": "Seed of the consumers' demand, the session keeps its seed when absent",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        }
+      }
+    },
+    "SessionInfo": {
+      "type": "object",
+      "required": [
+        "id",
+        "seed"
+      ],
+      "properties": {
+        "cycleCounter": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "runId": {
+          "description": "Current run in the store, empty when the server has no store",
+          "type": "string"
+        },
+        "seed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "OrdersPlacement or Ordering, as in SystemInfo",
+          "type": "string"
+        }
+      }
+    },
+    "Snapshot": {
+      "description": "Full state of the simulation as saved by the emulator, see application.Snapshot",
+      "type": "object"
+    },
+    "StrategyConfig": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "description": "Built-in strategy name",
+          "type": "string"
+        },
+        "params": {
+          "description": "Strategy parameters",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        }
+      },
+      "additionalProperties": false
+    },
+    "SystemInfo": {
+      "type": "object",
+      "properties": {
+        "cycleCounter": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "OrdersPlacement",
+            "Ordering"
+          ]
+        }
+      }
+    },
+    "Upgrade": {
+      "type": "object",
+      "properties": {
+        "increases": {
+          "description": "Maximal capacity increase after upgrade",
+          "type": "integer"
+        },
+        "require": {
+          "description": "Product required for upgrade",
+          "type": "integer"
+        }
+      },
+      "additionalProperties": false
+    }
+  },
+  "securityDefinitions": {
+    "apiKey": {
+      "description": "Required by the agent commands and the system control when the server is started with API keys. A key is bound to the agents it may command or has the admin role.",
+      "type": "apiKey",
+      "name": "X-API-Key",
+      "in": "header"
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "tokenomics",
+    "version": "1.0.0"
+  },
+  "host": "localhost:8080",
+  "basePath": "/",
+  "paths": {
+    "/config/analyze": {
+      "post": {
+        "description": "The analysis builds the dependency graph of the capacity types, the products and the investments. The errors make the configuration unplayable and are rejected by the other endpoints: structural problems, products without process sheets or with capacity types no producer provides. The warnings are unused products and producers, degradation faster than restoration, capacity types needing each other's capacity to be restored and the steady-state capacity deficits.",
+        "summary": "Analyse a configuration without applying it",
+        "operationId": "analyzeConfig",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Configuration"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigAnalysis"
+            }
+          },
+          "400": {
+            "description": "Unsupported configuration version"
+          }
+        }
+      }
+    },
+    "/runs": {
+      "get": {
+        "description": "A run is a game of a session from a reset, a restored snapshot, a rewind or a fork. The runs are kept in the store of the server and outlive its restarts. Returned without their configurations in the order of creation.",
+        "summary": "List the persisted runs",
+        "operationId": "listRuns",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Return the runs of the given session only",
+            "name": "sessionId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/RunInfo"
+              }
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "The server has no store"
+          }
+        }
+      }
+    },
+    "/runs/{runId}": {
+      "get": {
+        "summary": "Get a persisted run with its configuration",
+        "operationId": "getRun",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/RunInfo"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Run not found or the server has no store"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "runId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/runs/{runId}/cycles": {
+      "get": {
+        "description": "Every cycle has its result and the market records of its auctions.",
+        "summary": "Get the completed cycles of a persisted run",
+        "operationId": "getRunCycles",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CycleRecord"
+              }
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Run not found or the server has no store"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "runId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/runs/{runId}/events": {
+      "get": {
+        "summary": "Get the events of a persisted run",
+        "operationId": "getRunEvents",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Event"
+              }
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Run not found or the server has no store"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "runId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/scenarios": {
+      "get": {
+        "description": "The scenarios are the JSON and YAML files of the scenarios directory, named by the file names without the extension. They are listed by name without their configurations, the invalid files are skipped.",
+        "summary": "List the scenarios of the library",
+        "operationId": "listScenarios",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Scenario"
+              }
+            }
+          }
+        }
+      }
+    },
+    "/scenarios/{name}": {
+      "get": {
+        "summary": "Get the scenario with its configuration",
+        "operationId": "getScenario",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Scenario"
+            }
+          },
+          "404": {
+            "description": "Scenario not found"
+          },
+          "500": {
+            "description": "Invalid scenario file"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions": {
+      "get": {
+        "summary": "List simulation sessions",
+        "operationId": "listSessions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SessionInfo"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "A session is an independent simulation with its own configuration, seed and system. The configuration file of the server is taken when the request has none. The session \"default\" is created on the server start when the file is valid.",
+        "summary": "Create a simulation session",
+        "operationId": "createSession",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateSessionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SessionInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid configuration, the session exists already or the request has no configuration and the file of the server is missing or invalid"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      }
+    },
+    "/sessions/{sessionId}": {
+      "get": {
+        "summary": "Get a simulation session",
+        "operationId": "getSession",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SessionInfo"
+            }
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Delete a simulation session",
+        "operationId": "deleteSession",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/checkpoints": {
+      "get": {
+        "description": "A checkpoint is the state at the start of a cycle, after the bots' investments. Only the checkpoints of the last 100 cycles are kept. The checkpoints start over on a reset or a restored snapshot.",
+        "summary": "List the cycles the session can be rewound to",
+        "operationId": "listCheckpoints",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "integer"
+              }
+            }
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/config": {
+      "get": {
+        "summary": "Get current system configuration",
+        "operationId": "getConfig",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Configuration"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "The configuration takes effect on the next reset, or at once when applied. An invalid configuration, including unknown keys, bot strategies and default actions, is rejected and the current one is kept.",
+        "summary": "Update system configuration",
+        "operationId": "updateConfig",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Configuration"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Reset the simulation with the configuration at once",
+            "name": "apply",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Configuration updated successfully"
+          },
+          "400": {
+            "description": "Invalid configuration"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "delete": {
+        "description": "The file is read again, so its edits are taken. The configuration takes effect on the next reset, or at once when applied.",
+        "summary": "Revert to the configuration file of the server",
+        "operationId": "revertConfig",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Reset the simulation with the configuration at once",
+            "name": "apply",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The configuration of the file",
+            "schema": {
+              "$ref": "#/definitions/Configuration"
+            }
+          },
+          "400": {
+            "description": "The configuration file is missing or invalid"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/config/analyze": {
+      "get": {
+        "description": "See POST /config/analyze.",
+        "summary": "Analyse the configuration of the session",
+        "operationId": "analyzeSessionConfig",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigAnalysis"
+            }
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/events": {
+      "get": {
+        "description": "Server-sent events: every event is sent with its type as the event name and the Event JSON as the data. Types: phase.changed, orders.created, bid.placed, investment.requested, investment.completed, investment.rejected, production, cycle.completed.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "summary": "Stream the events of the session",
+        "operationId": "streamEvents",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Comma separated ids of the agents to stream the events of, the system-wide events are always streamed. Required unless the API is open or the key is admin, the agents must be commanded by the key",
+            "name": "agentId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of events",
+            "schema": {
+              "$ref": "#/definitions/Event"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key may not watch all the agents of the filter, only an admin key may watch all agents"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/events/ws": {
+      "get": {
+        "description": "The connection is upgraded to WebSocket, every message is an Event JSON.",
+        "produces": [
+          "application/json"
+        ],
+        "summary": "Stream the events of the session over WebSocket",
+        "operationId": "streamEventsWebSocket",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Comma separated ids of the agents to stream the events of, the system-wide events are always streamed. Required unless the API is open or the key is admin, the agents must be commanded by the key",
+            "name": "agentId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of events",
+            "schema": {
+              "$ref": "#/definitions/Event"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key may not watch all the agents of the filter, only an admin key may watch all agents"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/fork": {
+      "post": {
+        "description": "The branch starts at the checkpoint of the cycle with the configuration of the request, or of the session when the request has none. The configuration must have the same agents and consumers. The session stays intact.",
+        "summary": "Fork a branch session from a cycle of the session",
+        "operationId": "forkSession",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ForkRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SessionInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid configuration or the branch session exists already"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session or checkpoint not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/gym/reset": {
+      "post": {
+        "description": "The environment plays an own copy of the current configuration, the agents marked as bots are driven by their strategies. Every step plays a single phase of a cycle: the investment phase for the producing agents, then the ordering phase for the ordering agents.",
+        "summary": "Start a new episode of the step environment",
+        "operationId": "resetGym",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GymResetRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Observation of the first phase",
+            "schema": {
+              "$ref": "#/definitions/GymObservation"
+            }
+          },
+          "400": {
+            "description": "Invalid request or configuration"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/gym/step": {
+      "post": {
+        "summary": "Play the current phase with the actions of its agents",
+        "operationId": "stepGym",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GymActions"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GymStepResult"
+            }
+          },
+          "400": {
+            "description": "Invalid actions or the episode is done"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/market/history": {
+      "get": {
+        "description": "Retrieve per cycle auction results of the producers: cut off prices, capacity utilisation and funds.",
+        "summary": "Get market history",
+        "operationId": "getMarketHistory",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "First cycle to include",
+            "name": "fromCycle",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Last cycle to include",
+            "name": "toCycle",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return records of the given producer only",
+            "name": "producerId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/MarketRecord"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/ordering-agents": {
+      "get": {
+        "summary": "Get ordering agents list",
+        "operationId": "listOrderingAgents",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/OrderingAgentInfo"
+              }
+            }
+          }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "ProducingAgentInfo": {
-      "type": "object",
-      "properties": {
-        "capacity": {
-          "description": "Current capacity value",
-          "type": "integer"
-        },
-        "capacityType": {
-          "description": "Capacity type",
-          "type": "string"
-        },
-        "cutOffPrice": {
-          "description": "The cut off price in the previous cycle",
-          "type": "integer"
-        },
-        "id": {
-          "description": "Agent ID",
-          "type": "string"
+    "/sessions/{sessionId}/ordering-agents/{id}": {
+      "get": {
+        "description": "Retrieve the view of an ordering agent.",
+        "summary": "Get Ordering Agent View",
+        "operationId": "getOrderingAgentView",
+        "responses": {
+          "200": {
+            "description": "Successful response",
+            "schema": {
+              "$ref": "#/definitions/OrderingAgentView"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Send a command to an ordering agent.",
+        "summary": "Submit Ordering Agent Command",
+        "operationId": "sendOrderingAgentCommand",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OrderingAgentCommand"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Command processed successfully"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key is not bound to the agent"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         },
-        "maxCapacity": {
-          "description": "Maximum capacity value",
-          "type": "integer"
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "ProducingAgentView": {
-      "type": "object",
-      "properties": {
-        "capacity": {
-          "description": "Current capacity. Degradates each turn. Could be increased to MaxCapacity with Restoration purchase",
-          "type": "integer"
-        },
-        "degradation": {
-          "description": "Capacity decrease in the current cycle",
-          "type": "integer"
-        },
-        "id": {
-          "description": "Agent ID",
-          "type": "string"
-        },
-        "maxCapacity": {
-          "description": "Maximum capacity. Can be increased with Upgrade purchase",
-          "type": "integer"
-        },
-        "requestedCapacity": {
-          "description": "Total capacity was requested in the previous cycle",
-          "type": "integer"
-        },
-        "restoration": {
-          "description": "Capacity gain with Restoration",
-          "type": "integer"
-        },
-        "restorationRunning": {
-          "description": "Indicates Restoration production is running",
-          "type": "boolean"
-        },
-        "upgrade": {
-          "description": "MaxCapacity and Capacity gain with Upgrade",
-          "type": "integer"
+    "/sessions/{sessionId}/ordering-agents/{id}/pass": {
+      "post": {
+        "description": "An ordering agent ends its turn in the current phase without a command, so the lockstep mode doesn't wait for it.",
+        "summary": "Pass the turn of an ordering agent",
+        "operationId": "passOrderingAgent",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Wrong phase or the turn is over already"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key is not bound to the agent"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         },
-        "upgradeRunning": {
-          "description": "Indicates Upgrade production is running",
-          "type": "boolean"
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "Restoration": {
-      "type": "object"
+    "/sessions/{sessionId}/producer-agents": {
+      "get": {
+        "summary": "Get producing agents list",
+        "operationId": "listProducingAgents",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ProducingAgentInfo"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "SystemInfo": {
-      "type": "object",
-      "properties": {
-        "cycleCounter": {
-          "type": "integer"
+    "/sessions/{sessionId}/producing-agents/{id}": {
+      "get": {
+        "description": "Retrieve the view of a producing agent.",
+        "summary": "Get Producing Agent View",
+        "operationId": "getProducingAgentView",
+        "responses": {
+          "200": {
+            "description": "Successful response",
+            "schema": {
+              "$ref": "#/definitions/ProducingAgentView"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Send a command to a producing agent.",
+        "summary": "Submit Producing Agent Command",
+        "operationId": "sendProducingAgentCommand",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ProducingAgentCommand"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Command processed successfully"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key is not bound to the agent"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         },
-        "state": {
+        {
           "type": "string",
-          "enum": [
-            "OrdersPlacement",
-            "Ordering"
-          ]
+          "name": "id",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "Upgrade": {
-      "type": "object",
-      "properties": {
-        "capacity": {
-          "description": "Capacity increase after upgrade",
-          "type": "integer"
+    "/sessions/{sessionId}/producing-agents/{id}/pass": {
+      "post": {
+        "description": "A producing agent ends its turn in the current phase without a command, so the lockstep mode doesn't wait for it.",
+        "summary": "Pass the turn of a producing agent",
+        "operationId": "passProducingAgent",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Wrong phase or the turn is over already"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key is not bound to the agent"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
         },
-        "product": {
-          "description": "Product required for upgrade",
-          "type": "integer"
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
         }
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "tokenomics",
-    "version": "1.0.0"
-  },
-  "host": "localhost:8080",
-  "basePath": "/",
-  "paths": {
-    "/config": {
-      "get": {
-        "summary": "Get current system configuration",
-        "operationId": "getConfig",
+      ]
+    },
+    "/sessions/{sessionId}/rewind": {
+      "post": {
+        "description": "The later cycles and their checkpoints are dropped.",
+        "summary": "Rewind the session to the start of a cycle",
+        "operationId": "rewindSession",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RewindRequest"
+            }
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Configuration"
+              "$ref": "#/definitions/SystemInfo"
             }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session or checkpoint not found"
           }
         }
       },
-      "put": {
-        "summary": "Update system configuration",
-        "operationId": "updateConfig",
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/scenario": {
+      "post": {
+        "description": "The simulation starts over with the configuration of the scenario, and with its seed when it has one.",
+        "summary": "Reset the session into a scenario",
+        "operationId": "loadScenario",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Configuration"
+              "$ref": "#/definitions/LoadScenarioRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Configuration updated successfully"
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SessionInfo"
+            }
           },
-          "400": {
-            "description": "Invalid configuration"
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session or scenario not found"
+          },
+          "500": {
+            "description": "Invalid scenario file"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/ordering-agents": {
+    "/sessions/{sessionId}/system": {
       "get": {
-        "summary": "Get ordering agents list",
-        "operationId": "listOrderingAgents",
+        "summary": "Get system state",
+        "operationId": "getSystemInfo",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/OrderingAgentInfo"
+                "$ref": "#/definitions/SystemInfo"
               }
             }
           }
         }
-      }
+      },
+      "delete": {
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Reset emulation state",
+        "operationId": "resetSystem",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/ordering-agents/{id}": {
+    "/sessions/{sessionId}/system/commands": {
       "get": {
-        "description": "Retrieve the view of an ordering agent.",
-        "summary": "Get Ordering Agent View",
-        "operationId": "getOrderingAgentView",
+        "description": "Append-only log of the accepted commands: resets with the configuration and the seed, restores, rewinds, producing and ordering agent commands and phase transitions with the cycle results. The server keeps the commands of the current game since its last reset or restore. The log is replayed by tokenomics-replay.",
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Get the command log",
+        "operationId": "getCommandLog",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Number of the first returned command, the log starts with 1",
+            "name": "fromSeq",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Successful response",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/OrderingAgentView"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Command"
+              }
             }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
       },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/complete-cycle": {
       "post": {
-        "description": "Send a command to an ordering agent.",
-        "summary": "Submit Ordering Agent Command",
-        "operationId": "sendOrderingAgentCommand",
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Complete current cycle",
+        "operationId": "completeCycle",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/CycleResult"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/missed-deadlines": {
+      "get": {
+        "description": "Numbers of the phase deadlines missed by the agents since the last reset. An agent misses a deadline when it hasn't sent its command by the end of the phase, the configured default action is taken for it then.",
+        "summary": "Get missed phase deadlines",
+        "operationId": "getMissedDeadlines",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/MissedDeadlines"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/snapshot": {
+      "get": {
+        "description": "The snapshot has the configuration, the seed, the producers with their bookings and running investments, the orders with their part statuses, the order id generator, the cycle counter and the missed deadlines.",
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Export the full state of the simulation",
+        "operationId": "exportSnapshot",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Snapshot"
+            }
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "put": {
+        "description": "The session takes the configuration and the seed of the snapshot and goes on from its phase. The bots start over, the state of their strategies isn't kept.",
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Restore the simulation from a snapshot",
+        "operationId": "importSnapshot",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/OrderingAgentCommand"
+              "$ref": "#/definitions/Snapshot"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Command processed successfully"
+            "description": "Snapshot restored"
+          },
+          "400": {
+            "description": "Invalid snapshot"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
+          },
+          "404": {
+            "description": "Session not found"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{sessionId}/system/start-ordering": {
+      "post": {
+        "tags": [
+          "Tokenomics"
+        ],
+        "summary": "Complete investment phase",
+        "operationId": "startOrdering",
+        "security": [
+          {
+            "apiKey": []
+          },
+          {}
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Missing or unknown API key"
+          },
+          "403": {
+            "description": "The API key has no admin role"
           }
         }
       },
       "parameters": [
         {
           "type": "string",
-          "name": "id",
+          "name": "sessionId",
           "in": "path",
           "required": true
         }
       ]
     },
-    "/producer-agents": {
-      "get": {
-        "summary": "Get producing agents list",
-        "operationId": "listProducingAgents",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ProducingAgentInfo"
-              }
-            }
-          }
-        }
-      }
-    },
-    "/producing-agents/{id}": {
+    "/sessions/{sessionId}/system/wait": {
       "get": {
-        "description": "Retrieve the view of a producing agent.",
-        "summary": "Get Producing Agent View",
-        "operationId": "getProducingAgentView",
-        "responses": {
-          "200": {
-            "description": "Successful response",
-            "schema": {
-              "$ref": "#/definitions/ProducingAgentView"
-            }
-          }
-        }
-      },
-      "post": {
-        "description": "Send a command to a producing agent.",
-        "summary": "Submit Producing Agent Command",
-        "operationId": "sendProducingAgentCommand",
+        "description": "Long polling for the agents playing without a controller: the response is sent as soon as the system leaves the known phase, or with the unchanged phase on the timeout.",
+        "summary": "Wait for the phase change",
+        "operationId": "waitPhase",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ProducingAgentCommand"
-            }
+            "type": "integer",
+            "description": "Cycle counter of the known phase",
+            "name": "cycleCounter",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "State of the known phase: OrdersPlacement or Ordering",
+            "name": "state",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Timeout of the wait, 30 seconds by default and 5 minutes at most",
+            "name": "timeoutMs",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Command processed successfully"
+            "description": "Current phase",
+            "schema": {
+              "$ref": "#/definitions/SystemInfo"
+            }
+          },
+          "404": {
+            "description": "Session not found"
           }
         }
       },
       "parameters": [
         {
           "type": "string",
-          "name": "id",
+          "name": "sessionId",
           "in": "path",
           "required": true
         }
       ]
+    }
+  },
+  "definitions": {
+    "Command": {
+      "description": "Accepted command of the command log, see application.Command",
+      "type": "object"
     },
-    "/system": {
-      "get": {
-        "summary": "Get system state",
-        "operationId": "getSystemInfo",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/SystemInfo"
-              }
-            }
+    "ConfigAnalysis": {
+      "type": "object",
+      "required": [
+        "errors",
+        "warnings"
+      ],
+      "properties": {
+        "demand": {
+          "description": "Steady-state demand per cycle by capacity type: the consumers' orders and the restorations making up for the degradation",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigFinding"
+          }
+        },
+        "supply": {
+          "description": "Initial capacity of the producers by capacity type",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        },
+        "warnings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigFinding"
+          }
+        }
+      }
+    },
+    "ConfigFinding": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "invalid, unknown-product, unreachable-product, unused-product, unused-capacity, investment-cycle, no-restoration, restoration-deficit or capacity-deficit",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "subject": {
+          "description": "Product, capacity type or agent of the finding",
+          "type": "string"
+        }
+      }
+    },
+    "Configuration": {
+      "type": "object",
+      "required": [
+        "cycleEmission",
+        "processSheets",
+        "producerConfigs"
+      ],
+      "properties": {
+        "consumers": {
+          "description": "Final consumers randomly ordering the listed products",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConsumerConfig"
+          }
+        },
+        "cycleEmission": {
+          "description": "Amount of tokens emitted each cycle",
+          "type": "integer"
+        },
+        "deadlines": {
+          "$ref": "#/definitions/Deadlines"
+        },
+        "orderingBots": {
+          "description": "Ordering agents controlled by built-in strategies",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/OrderingBotConfig"
+          }
+        },
+        "processSheets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProcessSheet"
+          }
+        },
+        "producerConfigs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProducingAgentConfig"
+          }
+        },
+        "producingBots": {
+          "description": "Producing agents controlled by built-in strategies",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProducingBotConfig"
           }
+        },
+        "version": {
+          "description": "Schema version of the configuration, the current one when absent. The API takes the current version only, the older files are migrated by the server on loading.",
+          "type": "integer"
         }
       },
-      "delete": {
-        "tags": [
-          "Tokenomics"
-        ],
-        "summary": "Reset emulation state",
-        "operationId": "resetSystem",
-        "responses": {
-          "200": {
-            "description": "OK"
+      "additionalProperties": false
+    },
+    "ConsumerConfig": {
+      "type": "object",
+      "required": [
+        "id",
+        "products"
+      ],
+      "properties": {
+        "id": {
+          "description": "Consumer identifier",
+          "type": "string"
+        },
+        "products": {
+          "description": "Products the consumer orders",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        }
+      },
+      "additionalProperties": false
+    },
+    "CreateSessionRequest": {
+      "type": "object",
+      "properties": {
+        "config": {
+          "$ref": "#/definitions/Configuration"
+        },
+        "id": {
+          "description": "Session id, generated when empty",
+          "type": "string"
+        },
+        "scenario": {
+          "description": "Scenario of the library the session starts with, instead of the config",
+          "type": "string"
+        },
+        "seed": {
+          "description": "Seed of the consumers' demand, of the scenario when zero and the scenario has one",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        }
+      }
+    },
+    "CycleRecord": {
+      "description": "Completed cycle of a run with its result and market records, see application.CycleRecord",
+      "type": "object"
+    },
+    "CycleResult": {
+      "type": "object",
+      "required": [
+        "score"
+      ],
+      "properties": {
+        "score": {
+          "type": "integer"
+        }
+      }
+    },
+    "Deadlines": {
+      "description": "Deadlines of the investment phase (producing agents) and the ordering phase (ordering agents)",
+      "type": "object",
+      "properties": {
+        "defaultOrdering": {
+          "$ref": "#/definitions/DefaultAction"
+        },
+        "defaultProducing": {
+          "$ref": "#/definitions/DefaultAction"
+        },
+        "investment": {
+          "$ref": "#/definitions/PhaseDeadline"
+        },
+        "ordering": {
+          "$ref": "#/definitions/PhaseDeadline"
+        }
+      },
+      "additionalProperties": false
+    },
+    "DefaultAction": {
+      "description": "Action taken for an agent which missed the deadline",
+      "type": "object",
+      "properties": {
+        "kind": {
+          "description": "abstain (the default), repeat (the last command) or strategy",
+          "type": "string"
+        },
+        "strategy": {
+          "$ref": "#/definitions/StrategyConfig"
+        }
+      },
+      "additionalProperties": false
+    },
+    "Event": {
+      "type": "object",
+      "required": [
+        "seq",
+        "type",
+        "cycle"
+      ],
+      "properties": {
+        "agentId": {
+          "description": "Agent of the event, empty for the system-wide events",
+          "type": "string"
+        },
+        "cycle": {
+          "type": "integer"
+        },
+        "data": {
+          "description": "Payload of the event type",
+          "type": "object"
+        },
+        "seq": {
+          "description": "Number of the event in the session",
+          "type": "integer"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "ForkRequest": {
+      "type": "object",
+      "required": [
+        "cycle"
+      ],
+      "properties": {
+        "config": {
+          "$ref": "#/definitions/Configuration"
+        },
+        "cycle": {
+          "description": "Cycle the branch starts at",
+          "type": "integer",
+          "minimum": 1
+        },
+        "id": {
+          "description": "Branch session id, generated when empty",
+          "type": "string"
+        }
+      }
+    },
+    "GymActions": {
+      "description": "Commands of the agents acting in the current phase, an agent without a command stays idle",
+      "type": "object",
+      "properties": {
+        "ordering": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GymOrderingAction"
+          }
+        },
+        "producing": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GymProducingAction"
+          }
+        }
+      }
+    },
+    "GymObservation": {
+      "type": "object",
+      "required": [
+        "cycle",
+        "phase"
+      ],
+      "properties": {
+        "cycle": {
+          "type": "integer"
+        },
+        "orderingAgents": {
+          "description": "Views of the ordering agents in the ordering phase",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GymOrderingAgentView"
+          }
+        },
+        "phase": {
+          "description": "investment or ordering",
+          "type": "string"
+        },
+        "producingAgents": {
+          "description": "Views of the producing agents in the investment phase",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProducingAgentView"
           }
         }
       }
     },
-    "/system/complete-cycle": {
-      "post": {
-        "tags": [
-          "Tokenomics"
-        ],
-        "summary": "Complete current cycle",
-        "operationId": "completeCycle",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/CycleResult"
-            }
-          }
+    "GymOrderingAction": {
+      "type": "object",
+      "required": [
+        "agentId",
+        "command"
+      ],
+      "properties": {
+        "agentId": {
+          "type": "string"
+        },
+        "command": {
+          "$ref": "#/definitions/OrderingAgentCommand"
         }
       }
     },
-    "/system/start-ordering": {
-      "post": {
-        "tags": [
-          "Tokenomics"
-        ],
-        "summary": "Complete investment phase",
-        "operationId": "startOrdering",
-        "responses": {
-          "200": {
-            "description": "OK"
-          }
+    "GymOrderingAgentView": {
+      "type": "object",
+      "required": [
+        "agentId",
+        "view"
+      ],
+      "properties": {
+        "agentId": {
+          "type": "string"
+        },
+        "view": {
+          "$ref": "#/definitions/OrderingAgentView"
         }
       }
-    }
-  },
-  "definitions": {
-    "Configuration": {
+    },
+    "GymProducingAction": {
       "type": "object",
       "required": [
-        "cycleEmission",
-        "processSheets",
-        "producerConfigs"
+        "producerId",
+        "command"
       ],
       "properties": {
-        "cycleEmission": {
-          "description": "Amount of tokens emitted each cycle",
+        "command": {
+          "$ref": "#/definitions/ProducingAgentCommand"
+        },
+        "producerId": {
+          "type": "string"
+        }
+      }
+    },
+    "GymResetRequest": {
+      "type": "object",
+      "required": [
+        "cycles"
+      ],
+      "properties": {
+        "cycles": {
+          "description": "Number of cycles in the episode",
           "type": "integer"
         },
-        "processSheets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ProcessSheet"
+        "seed": {
+          "description": "Seed of the consumers' demand",
+          "type": "integer"
+        }
+      }
+    },
+    "GymStepResult": {
+      "type": "object",
+      "required": [
+        "observation",
+        "score",
+        "done"
+      ],
+      "properties": {
+        "done": {
+          "type": "boolean"
+        },
+        "observation": {
+          "$ref": "#/definitions/GymObservation"
+        },
+        "orderingRewards": {
+          "description": "Negated score of the agent's orders in the completed cycle",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
           }
         },
-        "producerConfigs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ProducingAgentConfig"
+        "producingRewards": {
+          "description": "Tokens collected by the producer's auction in the completed cycle",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        },
+        "rejected": {
+          "description": "Errors of the commands refused by the system by agent id, such agents stay idle",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
+        },
+        "score": {
+          "description": "Score of the completed cycle, zero after the investment phase",
+          "type": "integer"
         }
       }
     },
-    "CycleResult": {
+    "LoadScenarioRequest": {
       "type": "object",
       "required": [
-        "score"
+        "name"
       ],
       "properties": {
-        "score": {
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "MarketRecord": {
+      "type": "object",
+      "properties": {
+        "acceptedCapacity": {
+          "description": "Capacity of the accepted bids",
+          "type": "integer"
+        },
+        "availableCapacity": {
+          "description": "Producer capacity at the start of the production",
           "type": "integer"
+        },
+        "capacityType": {
+          "description": "Capacity type",
+          "type": "string"
+        },
+        "cutOffPrice": {
+          "description": "The cut off price of the cycle. Absent if no bid was accepted",
+          "type": "number",
+          "x-nullable": true
+        },
+        "cycle": {
+          "description": "Cycle number",
+          "type": "integer"
+        },
+        "funds": {
+          "description": "Tokens collected from the accepted bids",
+          "type": "integer"
+        },
+        "producerId": {
+          "description": "Producer ID",
+          "type": "string"
+        },
+        "rejectedCapacity": {
+          "description": "Capacity of the rejected bids",
+          "type": "integer"
+        },
+        "requestedCapacity": {
+          "description": "Total capacity requested by the bids",
+          "type": "integer"
+        }
+      }
+    },
+    "MissedDeadlines": {
+      "type": "object",
+      "required": [
+        "agentId",
+        "phase",
+        "missed"
+      ],
+      "properties": {
+        "agentId": {
+          "type": "string"
+        },
+        "missed": {
+          "type": "integer"
+        },
+        "phase": {
+          "description": "investment or ordering",
+          "type": "string"
         }
       }
     },
@@ -871,6 +4467,12 @@ func init() {
               "$ref": "#/definitions/ProducingAgentInfo"
             }
           }
+        },
+        "tokens": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
         }
       },
       "example": {
@@ -897,9 +4499,45 @@ func init() {
               "upgradeRunning": "true"
             }
           }
+        },
+        "tokens": {
+          "order-1": 400,
+          "order-2": 60
         }
       }
     },
+    "OrderingBotConfig": {
+      "type": "object",
+      "required": [
+        "agentId",
+        "strategy"
+      ],
+      "properties": {
+        "agentId": {
+          "description": "Ordering agent identifier",
+          "type": "string"
+        },
+        "strategy": {
+          "$ref": "#/definitions/StrategyConfig"
+        }
+      },
+      "additionalProperties": false
+    },
+    "PhaseDeadline": {
+      "description": "A phase without a deadline ends only when the next phase is started explicitly",
+      "type": "object",
+      "properties": {
+        "allSubmitted": {
+          "description": "End the phase as soon as the last pending agent has submitted its command",
+          "type": "boolean"
+        },
+        "timeoutMs": {
+          "description": "Wall-clock timeout of the phase in milliseconds",
+          "type": "integer"
+        }
+      },
+      "additionalProperties": false
+    },
     "ProcessSheet": {
       "type": "object",
       "required": [
@@ -918,7 +4556,8 @@ func init() {
             "type": "integer"
           }
         }
-      }
+      },
+      "additionalProperties": false
     },
     "ProducingAgentCommand": {
       "type": "object",
@@ -964,7 +4603,8 @@ func init() {
         "upgrade": {
           "$ref": "#/definitions/Upgrade"
         }
-      }
+      },
+      "additionalProperties": false
     },
     "ProducingAgentInfo": {
       "type": "object",
@@ -978,8 +4618,9 @@ func init() {
           "type": "string"
         },
         "cutOffPrice": {
-          "description": "The cut off price in the previous cycle",
-          "type": "integer"
+          "description": "The cut off price in the previous cycle. Absent before the first accepted bid",
+          "type": "number",
+          "x-nullable": true
         },
         "id": {
           "description": "Agent ID",
@@ -1032,9 +4673,169 @@ func init() {
         }
       }
     },
+    "ProducingBotConfig": {
+      "type": "object",
+      "required": [
+        "producerId",
+        "strategy"
+      ],
+      "properties": {
+        "producerId": {
+          "description": "Producer identifier",
+          "type": "string"
+        },
+        "strategy": {
+          "$ref": "#/definitions/StrategyConfig"
+        }
+      },
+      "additionalProperties": false
+    },
     "Restoration": {
+      "type": "object",
+      "properties": {
+        "require": {
+          "description": "Product required for restoration",
+          "type": "integer"
+        },
+        "restores": {
+          "description": "Capacity restored after restoration, up to the maximal capacity",
+          "type": "integer"
+        }
+      },
+      "additionalProperties": false
+    },
+    "RewindRequest": {
+      "type": "object",
+      "required": [
+        "cycle"
+      ],
+      "properties": {
+        "cycle": {
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "RunInfo": {
+      "type": "object",
+      "required": [
+        "id",
+        "sessionId"
+      ],
+      "properties": {
+        "config": {
+          "$ref": "#/definitions/Configuration"
+        },
+        "cycles": {
+          "description": "Number of the completed cycles",
+          "type": "integer"
+        },
+        "fromCycle": {
+          "description": "First cycle of the run, later than 1 for the restored games",
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "score": {
+          "description": "Total score of the completed cycles",
+          "type": "integer"
+        },
+        "seed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sessionId": {
+          "type": "string"
+        },
+        "started": {
+          "description": "Start time in RFC 3339",
+          "type": "string"
+        }
+      }
+    },
+    "Scenario": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "config": {
+          "$ref": "#/definitions/Configuration"
+        },
+        "description": {
+          "type": "string"
+        },
+        "difficulty": {
+          "description": "Free-form, e.g. easy, medium or hard",
+          "type": "string"
+        },
+        "expected": {
+          "description": "Behaviour the scenario is meant to show",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "seed": {
+          "description": "Seed of the consumers' demand, the session keeps its seed when absent",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        }
+      }
+    },
+    "SessionInfo": {
+      "type": "object",
+      "required": [
+        "id",
+        "seed"
+      ],
+      "properties": {
+        "cycleCounter": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "runId": {
+          "description": "Current run in the store, empty when the server has no store",
+          "type": "string"
+        },
+        "seed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "OrdersPlacement or Ordering, as in SystemInfo",
+          "type": "string"
+        }
+      }
+    },
+    "Snapshot": {
+      "description": "Full state of the simulation as saved by the emulator, see application.Snapshot",
       "type": "object"
     },
+    "StrategyConfig": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "description": "Built-in strategy name",
+          "type": "string"
+        },
+        "params": {
+          "description": "Strategy parameters",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number"
+          }
+        }
+      },
+      "additionalProperties": false
+    },
     "SystemInfo": {
       "type": "object",
       "properties": {
@@ -1053,15 +4854,24 @@ func init() {
     "Upgrade": {
       "type": "object",
       "properties": {
-        "capacity": {
-          "description": "Capacity increase after upgrade",
+        "increases": {
+          "description": "Maximal capacity increase after upgrade",
           "type": "integer"
         },
-        "product": {
+        "require": {
           "description": "Product required for upgrade",
           "type": "integer"
         }
-      }
+      },
+      "additionalProperties": false
+    }
+  },
+  "securityDefinitions": {
+    "apiKey": {
+      "description": "Required by the agent commands and the system control when the server is started with API keys. A key is bound to the agents it may command or has the admin role.",
+      "type": "apiKey",
+      "name": "X-API-Key",
+      "in": "header"
     }
   }
 }`))
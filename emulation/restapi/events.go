@@ -0,0 +1,120 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
+
+	"emulation/application"
+	"emulation/models"
+)
+
+// keepAlive is the period of the comments sent to keep an idle event stream open
+const keepAlive = 15 * time.Second
+
+// the dashboards and the game client are served from other origins
+var upgrader = websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+// agentIds parses the comma separated agent ids filter
+func agentIds(param *string) []string {
+	return lo.Compact(lo.Map(strings.Split(lo.FromPtr(param), ","), func(id string, _ int) string {
+		return strings.TrimSpace(id)
+	}))
+}
+
+// sseResponder streams the events as server-sent events until the client goes away or the subscription ends
+func sseResponder(r *http.Request, events <-chan application.Event, unsubscribe func()) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		defer unsubscribe()
+		flusher, ok := rw.(http.Flusher)
+		if !ok {
+			http.Error(rw, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+		rw.Header().Set("Content-Type", "text/event-stream")
+		rw.Header().Set("Cache-Control", "no-cache")
+		rw.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ticker := time.NewTicker(keepAlive)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-ticker.C:
+				fmt.Fprint(rw, ": keep-alive\n\n")
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(eventModel(event))
+				if err != nil {
+					slog.Error("events.sse.marshal_failed",
+						slog.String("error", err.Error()))
+					return
+				}
+				fmt.Fprintf(rw, "id: %d\nevent: %s\ndata: %s\n\n", event.Seq, event.Type, data)
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+// webSocketResponder upgrades the connection and sends an event per message
+// until the client closes the connection or the subscription ends
+func webSocketResponder(r *http.Request, events <-chan application.Event, unsubscribe func()) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		defer unsubscribe()
+		conn, err := upgrader.Upgrade(rw, r, nil)
+		if err != nil {
+			// the upgrader has responded with the error
+			return
+		}
+		defer conn.Close()
+
+		// the client messages are ignored, reading notices the closed connection
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-closed:
+				return
+			case event, ok := <-events:
+				if !ok {
+					conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+					return
+				}
+				if err := conn.WriteJSON(eventModel(event)); err != nil {
+					return
+				}
+			}
+		}
+	})
+}
+
+func eventModel(event application.Event) *models.Event {
+	return &models.Event{
+		Seq:     lo.ToPtr(int64(event.Seq)),
+		Type:    lo.ToPtr(event.Type),
+		Cycle:   lo.ToPtr(int64(event.Cycle)),
+		AgentID: event.AgentId,
+		Data:    event.Data,
+	}
+}
@@ -30,7 +30,7 @@ func NewGetConfig(ctx *middleware.Context, handler GetConfigHandler) *GetConfig
 }
 
 /*
-	GetConfig swagger:route GET /config getConfig
+	GetConfig swagger:route GET /sessions/{sessionId}/config getConfig
 
 Get current system configuration
 */
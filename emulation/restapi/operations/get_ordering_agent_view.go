@@ -30,7 +30,7 @@ func NewGetOrderingAgentView(ctx *middleware.Context, handler GetOrderingAgentVi
 }
 
 /*
-	GetOrderingAgentView swagger:route GET /ordering-agents/{id} getOrderingAgentView
+	GetOrderingAgentView swagger:route GET /sessions/{sessionId}/ordering-agents/{id} getOrderingAgentView
 
 # Get Ordering Agent View
 
@@ -30,7 +30,7 @@ func NewGetProducingAgentView(ctx *middleware.Context, handler GetProducingAgent
 }
 
 /*
-	GetProducingAgentView swagger:route GET /producing-agents/{id} getProducingAgentView
+	GetProducingAgentView swagger:route GET /sessions/{sessionId}/producing-agents/{id} getProducingAgentView
 
 # Get Producing Agent View
 
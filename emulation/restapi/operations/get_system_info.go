@@ -30,7 +30,7 @@ func NewGetSystemInfo(ctx *middleware.Context, handler GetSystemInfoHandler) *Ge
 }
 
 /*
-	GetSystemInfo swagger:route GET /system getSystemInfo
+	GetSystemInfo swagger:route GET /sessions/{sessionId}/system getSystemInfo
 
 Get system state
 */
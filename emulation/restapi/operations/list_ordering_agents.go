@@ -30,7 +30,7 @@ func NewListOrderingAgents(ctx *middleware.Context, handler ListOrderingAgentsHa
 }
 
 /*
-	ListOrderingAgents swagger:route GET /ordering-agents listOrderingAgents
+	ListOrderingAgents swagger:route GET /sessions/{sessionId}/ordering-agents listOrderingAgents
 
 Get ordering agents list
 */
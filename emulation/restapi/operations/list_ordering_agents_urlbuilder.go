@@ -9,11 +9,16 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+	"strings"
 )
 
 // ListOrderingAgentsURL generates an URL for the list ordering agents operation
 type ListOrderingAgentsURL struct {
+	SessionID string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -35,7 +40,14 @@ func (o *ListOrderingAgentsURL) SetBasePath(bp string) {
 func (o *ListOrderingAgentsURL) Build() (*url.URL, error) {
 	var _result url.URL
 
-	var _path = "/ordering-agents"
+	var _path = "/sessions/{sessionId}/ordering-agents"
+
+	sessionID := o.SessionID
+	if sessionID != "" {
+		_path = strings.Replace(_path, "{sessionId}", sessionID, -1)
+	} else {
+		return nil, errors.New("sessionId is required on ListOrderingAgentsURL")
+	}
 
 	_basePath := o._basePath
 	if _basePath == "" {
@@ -30,7 +30,7 @@ func NewListProducingAgents(ctx *middleware.Context, handler ListProducingAgents
 }
 
 /*
-	ListProducingAgents swagger:route GET /producer-agents listProducingAgents
+	ListProducingAgents swagger:route GET /sessions/{sessionId}/producer-agents listProducingAgents
 
 Get producing agents list
 */
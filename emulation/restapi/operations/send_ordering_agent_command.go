@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"emulation/models"
 )
 
 // SendOrderingAgentCommandHandlerFunc turns a function with the right signature into a send ordering agent command handler
-type SendOrderingAgentCommandHandlerFunc func(SendOrderingAgentCommandParams) middleware.Responder
+type SendOrderingAgentCommandHandlerFunc func(SendOrderingAgentCommandParams, *models.Principal) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn SendOrderingAgentCommandHandlerFunc) Handle(params SendOrderingAgentCommandParams) middleware.Responder {
-	return fn(params)
+func (fn SendOrderingAgentCommandHandlerFunc) Handle(params SendOrderingAgentCommandParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
 }
 
 // SendOrderingAgentCommandHandler interface for that can handle valid send ordering agent command params
 type SendOrderingAgentCommandHandler interface {
-	Handle(SendOrderingAgentCommandParams) middleware.Responder
+	Handle(SendOrderingAgentCommandParams, *models.Principal) middleware.Responder
 }
 
 // NewSendOrderingAgentCommand creates a new http.Handler for the send ordering agent command operation
@@ -30,7 +32,7 @@ func NewSendOrderingAgentCommand(ctx *middleware.Context, handler SendOrderingAg
 }
 
 /*
-	SendOrderingAgentCommand swagger:route POST /ordering-agents/{id} sendOrderingAgentCommand
+	SendOrderingAgentCommand swagger:route POST /sessions/{sessionId}/ordering-agents/{id} sendOrderingAgentCommand
 
 # Submit Ordering Agent Command
 
@@ -47,12 +49,25 @@ func (o *SendOrderingAgentCommand) ServeHTTP(rw http.ResponseWriter, r *http.Req
 		*r = *rCtx
 	}
 	var Params = NewSendOrderingAgentCommandParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -35,3 +35,53 @@ func (o *SendOrderingAgentCommandOK) WriteResponse(rw http.ResponseWriter, produ
 
 	rw.WriteHeader(200)
 }
+
+// SendOrderingAgentCommandUnauthorizedCode is the HTTP code returned for type SendOrderingAgentCommandUnauthorized
+const SendOrderingAgentCommandUnauthorizedCode int = 401
+
+/*
+SendOrderingAgentCommandUnauthorized Missing or unknown API key
+
+swagger:response sendOrderingAgentCommandUnauthorized
+*/
+type SendOrderingAgentCommandUnauthorized struct {
+}
+
+// NewSendOrderingAgentCommandUnauthorized creates SendOrderingAgentCommandUnauthorized with default headers values
+func NewSendOrderingAgentCommandUnauthorized() *SendOrderingAgentCommandUnauthorized {
+
+	return &SendOrderingAgentCommandUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SendOrderingAgentCommandUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SendOrderingAgentCommandForbiddenCode is the HTTP code returned for type SendOrderingAgentCommandForbidden
+const SendOrderingAgentCommandForbiddenCode int = 403
+
+/*
+SendOrderingAgentCommandForbidden The API key is not bound to the agent
+
+swagger:response sendOrderingAgentCommandForbidden
+*/
+type SendOrderingAgentCommandForbidden struct {
+}
+
+// NewSendOrderingAgentCommandForbidden creates SendOrderingAgentCommandForbidden with default headers values
+func NewSendOrderingAgentCommandForbidden() *SendOrderingAgentCommandForbidden {
+
+	return &SendOrderingAgentCommandForbidden{}
+}
+
+// WriteResponse to the client
+func (o *SendOrderingAgentCommandForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(403)
+}
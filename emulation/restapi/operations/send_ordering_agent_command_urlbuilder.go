@@ -14,7 +14,8 @@ import (
 
 // SendOrderingAgentCommandURL generates an URL for the send ordering agent command operation
 type SendOrderingAgentCommandURL struct {
-	ID string
+	ID        string
+	SessionID string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -40,7 +41,7 @@ func (o *SendOrderingAgentCommandURL) SetBasePath(bp string) {
 func (o *SendOrderingAgentCommandURL) Build() (*url.URL, error) {
 	var _result url.URL
 
-	var _path = "/ordering-agents/{id}"
+	var _path = "/sessions/{sessionId}/ordering-agents/{id}"
 
 	id := o.ID
 	if id != "" {
@@ -49,6 +50,13 @@ func (o *SendOrderingAgentCommandURL) Build() (*url.URL, error) {
 		return nil, errors.New("id is required on SendOrderingAgentCommandURL")
 	}
 
+	sessionID := o.SessionID
+	if sessionID != "" {
+		_path = strings.Replace(_path, "{sessionId}", sessionID, -1)
+	} else {
+		return nil, errors.New("sessionId is required on SendOrderingAgentCommandURL")
+	}
+
 	_basePath := o._basePath
 	if _basePath == "" {
 		_basePath = "/"
@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"emulation/models"
 )
 
 // SendProducingAgentCommandHandlerFunc turns a function with the right signature into a send producing agent command handler
-type SendProducingAgentCommandHandlerFunc func(SendProducingAgentCommandParams) middleware.Responder
+type SendProducingAgentCommandHandlerFunc func(SendProducingAgentCommandParams, *models.Principal) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn SendProducingAgentCommandHandlerFunc) Handle(params SendProducingAgentCommandParams) middleware.Responder {
-	return fn(params)
+func (fn SendProducingAgentCommandHandlerFunc) Handle(params SendProducingAgentCommandParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
 }
 
 // SendProducingAgentCommandHandler interface for that can handle valid send producing agent command params
 type SendProducingAgentCommandHandler interface {
-	Handle(SendProducingAgentCommandParams) middleware.Responder
+	Handle(SendProducingAgentCommandParams, *models.Principal) middleware.Responder
 }
 
 // NewSendProducingAgentCommand creates a new http.Handler for the send producing agent command operation
@@ -30,7 +32,7 @@ func NewSendProducingAgentCommand(ctx *middleware.Context, handler SendProducing
 }
 
 /*
-	SendProducingAgentCommand swagger:route POST /producing-agents/{id} sendProducingAgentCommand
+	SendProducingAgentCommand swagger:route POST /sessions/{sessionId}/producing-agents/{id} sendProducingAgentCommand
 
 # Submit Producing Agent Command
 
@@ -47,12 +49,25 @@ func (o *SendProducingAgentCommand) ServeHTTP(rw http.ResponseWriter, r *http.Re
 		*r = *rCtx
 	}
 	var Params = NewSendProducingAgentCommandParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
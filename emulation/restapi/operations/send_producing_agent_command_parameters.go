@@ -45,6 +45,11 @@ type SendProducingAgentCommandParams struct {
 	  In: path
 	*/
 	ID string
+	/*
+	  Required: true
+	  In: path
+	*/
+	SessionID string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -88,6 +93,11 @@ func (o *SendProducingAgentCommandParams) BindRequest(r *http.Request, route *mi
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	rSessionID, rhkSessionID, _ := route.Params.GetOK("sessionId")
+	if err := o.bindSessionID(rSessionID, rhkSessionID, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -107,3 +117,17 @@ func (o *SendProducingAgentCommandParams) bindID(rawData []string, hasKey bool,
 
 	return nil
 }
+
+// bindSessionID binds and validates parameter SessionID from path.
+func (o *SendProducingAgentCommandParams) bindSessionID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.SessionID = raw
+
+	return nil
+}
@@ -35,3 +35,53 @@ func (o *SendProducingAgentCommandOK) WriteResponse(rw http.ResponseWriter, prod
 
 	rw.WriteHeader(200)
 }
+
+// SendProducingAgentCommandUnauthorizedCode is the HTTP code returned for type SendProducingAgentCommandUnauthorized
+const SendProducingAgentCommandUnauthorizedCode int = 401
+
+/*
+SendProducingAgentCommandUnauthorized Missing or unknown API key
+
+swagger:response sendProducingAgentCommandUnauthorized
+*/
+type SendProducingAgentCommandUnauthorized struct {
+}
+
+// NewSendProducingAgentCommandUnauthorized creates SendProducingAgentCommandUnauthorized with default headers values
+func NewSendProducingAgentCommandUnauthorized() *SendProducingAgentCommandUnauthorized {
+
+	return &SendProducingAgentCommandUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SendProducingAgentCommandUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SendProducingAgentCommandForbiddenCode is the HTTP code returned for type SendProducingAgentCommandForbidden
+const SendProducingAgentCommandForbiddenCode int = 403
+
+/*
+SendProducingAgentCommandForbidden The API key is not bound to the agent
+
+swagger:response sendProducingAgentCommandForbidden
+*/
+type SendProducingAgentCommandForbidden struct {
+}
+
+// NewSendProducingAgentCommandForbidden creates SendProducingAgentCommandForbidden with default headers values
+func NewSendProducingAgentCommandForbidden() *SendProducingAgentCommandForbidden {
+
+	return &SendProducingAgentCommandForbidden{}
+}
+
+// WriteResponse to the client
+func (o *SendProducingAgentCommandForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(403)
+}
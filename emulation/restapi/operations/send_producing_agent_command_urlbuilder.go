@@ -14,7 +14,8 @@ import (
 
 // SendProducingAgentCommandURL generates an URL for the send producing agent command operation
 type SendProducingAgentCommandURL struct {
-	ID string
+	ID        string
+	SessionID string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -40,7 +41,7 @@ func (o *SendProducingAgentCommandURL) SetBasePath(bp string) {
 func (o *SendProducingAgentCommandURL) Build() (*url.URL, error) {
 	var _result url.URL
 
-	var _path = "/producing-agents/{id}"
+	var _path = "/sessions/{sessionId}/producing-agents/{id}"
 
 	id := o.ID
 	if id != "" {
@@ -49,6 +50,13 @@ func (o *SendProducingAgentCommandURL) Build() (*url.URL, error) {
 		return nil, errors.New("id is required on SendProducingAgentCommandURL")
 	}
 
+	sessionID := o.SessionID
+	if sessionID != "" {
+		_path = strings.Replace(_path, "{sessionId}", sessionID, -1)
+	} else {
+		return nil, errors.New("sessionId is required on SendProducingAgentCommandURL")
+	}
+
 	_basePath := o._basePath
 	if _basePath == "" {
 		_basePath = "/"
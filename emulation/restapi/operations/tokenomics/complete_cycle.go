@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"emulation/models"
 )
 
 // CompleteCycleHandlerFunc turns a function with the right signature into a complete cycle handler
-type CompleteCycleHandlerFunc func(CompleteCycleParams) middleware.Responder
+type CompleteCycleHandlerFunc func(CompleteCycleParams, *models.Principal) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn CompleteCycleHandlerFunc) Handle(params CompleteCycleParams) middleware.Responder {
-	return fn(params)
+func (fn CompleteCycleHandlerFunc) Handle(params CompleteCycleParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
 }
 
 // CompleteCycleHandler interface for that can handle valid complete cycle params
 type CompleteCycleHandler interface {
-	Handle(CompleteCycleParams) middleware.Responder
+	Handle(CompleteCycleParams, *models.Principal) middleware.Responder
 }
 
 // NewCompleteCycle creates a new http.Handler for the complete cycle operation
@@ -30,7 +32,7 @@ func NewCompleteCycle(ctx *middleware.Context, handler CompleteCycleHandler) *Co
 }
 
 /*
-	CompleteCycle swagger:route POST /system/complete-cycle Tokenomics completeCycle
+	CompleteCycle swagger:route POST /sessions/{sessionId}/system/complete-cycle Tokenomics completeCycle
 
 Complete current cycle
 */
@@ -45,12 +47,25 @@ func (o *CompleteCycle) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewCompleteCycleParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -57,3 +57,53 @@ func (o *CompleteCycleOK) WriteResponse(rw http.ResponseWriter, producer runtime
 		}
 	}
 }
+
+// CompleteCycleUnauthorizedCode is the HTTP code returned for type CompleteCycleUnauthorized
+const CompleteCycleUnauthorizedCode int = 401
+
+/*
+CompleteCycleUnauthorized Missing or unknown API key
+
+swagger:response completeCycleUnauthorized
+*/
+type CompleteCycleUnauthorized struct {
+}
+
+// NewCompleteCycleUnauthorized creates CompleteCycleUnauthorized with default headers values
+func NewCompleteCycleUnauthorized() *CompleteCycleUnauthorized {
+
+	return &CompleteCycleUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *CompleteCycleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// CompleteCycleForbiddenCode is the HTTP code returned for type CompleteCycleForbidden
+const CompleteCycleForbiddenCode int = 403
+
+/*
+CompleteCycleForbidden The API key has no admin role
+
+swagger:response completeCycleForbidden
+*/
+type CompleteCycleForbidden struct {
+}
+
+// NewCompleteCycleForbidden creates CompleteCycleForbidden with default headers values
+func NewCompleteCycleForbidden() *CompleteCycleForbidden {
+
+	return &CompleteCycleForbidden{}
+}
+
+// WriteResponse to the client
+func (o *CompleteCycleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(403)
+}
@@ -9,11 +9,16 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+	"strings"
 )
 
 // CompleteCycleURL generates an URL for the complete cycle operation
 type CompleteCycleURL struct {
+	SessionID string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -35,7 +40,14 @@ func (o *CompleteCycleURL) SetBasePath(bp string) {
 func (o *CompleteCycleURL) Build() (*url.URL, error) {
 	var _result url.URL
 
-	var _path = "/system/complete-cycle"
+	var _path = "/sessions/{sessionId}/system/complete-cycle"
+
+	sessionID := o.SessionID
+	if sessionID != "" {
+		_path = strings.Replace(_path, "{sessionId}", sessionID, -1)
+	} else {
+		return nil, errors.New("sessionId is required on CompleteCycleURL")
+	}
 
 	_basePath := o._basePath
 	if _basePath == "" {
@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"emulation/models"
 )
 
 // ResetSystemHandlerFunc turns a function with the right signature into a reset system handler
-type ResetSystemHandlerFunc func(ResetSystemParams) middleware.Responder
+type ResetSystemHandlerFunc func(ResetSystemParams, *models.Principal) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn ResetSystemHandlerFunc) Handle(params ResetSystemParams) middleware.Responder {
-	return fn(params)
+func (fn ResetSystemHandlerFunc) Handle(params ResetSystemParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
 }
 
 // ResetSystemHandler interface for that can handle valid reset system params
 type ResetSystemHandler interface {
-	Handle(ResetSystemParams) middleware.Responder
+	Handle(ResetSystemParams, *models.Principal) middleware.Responder
 }
 
 // NewResetSystem creates a new http.Handler for the reset system operation
@@ -30,7 +32,7 @@ func NewResetSystem(ctx *middleware.Context, handler ResetSystemHandler) *ResetS
 }
 
 /*
-	ResetSystem swagger:route DELETE /system Tokenomics resetSystem
+	ResetSystem swagger:route DELETE /sessions/{sessionId}/system Tokenomics resetSystem
 
 Reset emulation state
 */
@@ -45,12 +47,25 @@ func (o *ResetSystem) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewResetSystemParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -35,3 +35,53 @@ func (o *ResetSystemOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 
 	rw.WriteHeader(200)
 }
+
+// ResetSystemUnauthorizedCode is the HTTP code returned for type ResetSystemUnauthorized
+const ResetSystemUnauthorizedCode int = 401
+
+/*
+ResetSystemUnauthorized Missing or unknown API key
+
+swagger:response resetSystemUnauthorized
+*/
+type ResetSystemUnauthorized struct {
+}
+
+// NewResetSystemUnauthorized creates ResetSystemUnauthorized with default headers values
+func NewResetSystemUnauthorized() *ResetSystemUnauthorized {
+
+	return &ResetSystemUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ResetSystemUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ResetSystemForbiddenCode is the HTTP code returned for type ResetSystemForbidden
+const ResetSystemForbiddenCode int = 403
+
+/*
+ResetSystemForbidden The API key has no admin role
+
+swagger:response resetSystemForbidden
+*/
+type ResetSystemForbidden struct {
+}
+
+// NewResetSystemForbidden creates ResetSystemForbidden with default headers values
+func NewResetSystemForbidden() *ResetSystemForbidden {
+
+	return &ResetSystemForbidden{}
+}
+
+// WriteResponse to the client
+func (o *ResetSystemForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(403)
+}
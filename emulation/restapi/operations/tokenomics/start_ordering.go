@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"emulation/models"
 )
 
 // StartOrderingHandlerFunc turns a function with the right signature into a start ordering handler
-type StartOrderingHandlerFunc func(StartOrderingParams) middleware.Responder
+type StartOrderingHandlerFunc func(StartOrderingParams, *models.Principal) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn StartOrderingHandlerFunc) Handle(params StartOrderingParams) middleware.Responder {
-	return fn(params)
+func (fn StartOrderingHandlerFunc) Handle(params StartOrderingParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
 }
 
 // StartOrderingHandler interface for that can handle valid start ordering params
 type StartOrderingHandler interface {
-	Handle(StartOrderingParams) middleware.Responder
+	Handle(StartOrderingParams, *models.Principal) middleware.Responder
 }
 
 // NewStartOrdering creates a new http.Handler for the start ordering operation
@@ -30,7 +32,7 @@ func NewStartOrdering(ctx *middleware.Context, handler StartOrderingHandler) *St
 }
 
 /*
-	StartOrdering swagger:route POST /system/start-ordering Tokenomics startOrdering
+	StartOrdering swagger:route POST /sessions/{sessionId}/system/start-ordering Tokenomics startOrdering
 
 Complete investment phase
 */
@@ -45,12 +47,25 @@ func (o *StartOrdering) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewStartOrderingParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -35,3 +35,53 @@ func (o *StartOrderingOK) WriteResponse(rw http.ResponseWriter, producer runtime
 
 	rw.WriteHeader(200)
 }
+
+// StartOrderingUnauthorizedCode is the HTTP code returned for type StartOrderingUnauthorized
+const StartOrderingUnauthorizedCode int = 401
+
+/*
+StartOrderingUnauthorized Missing or unknown API key
+
+swagger:response startOrderingUnauthorized
+*/
+type StartOrderingUnauthorized struct {
+}
+
+// NewStartOrderingUnauthorized creates StartOrderingUnauthorized with default headers values
+func NewStartOrderingUnauthorized() *StartOrderingUnauthorized {
+
+	return &StartOrderingUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *StartOrderingUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// StartOrderingForbiddenCode is the HTTP code returned for type StartOrderingForbidden
+const StartOrderingForbiddenCode int = 403
+
+/*
+StartOrderingForbidden The API key has no admin role
+
+swagger:response startOrderingForbidden
+*/
+type StartOrderingForbidden struct {
+}
+
+// NewStartOrderingForbidden creates StartOrderingForbidden with default headers values
+func NewStartOrderingForbidden() *StartOrderingForbidden {
+
+	return &StartOrderingForbidden{}
+}
+
+// WriteResponse to the client
+func (o *StartOrderingForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(403)
+}
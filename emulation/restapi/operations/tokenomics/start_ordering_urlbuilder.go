@@ -9,11 +9,16 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+	"strings"
 )
 
 // StartOrderingURL generates an URL for the start ordering operation
 type StartOrderingURL struct {
+	SessionID string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -35,7 +40,14 @@ func (o *StartOrderingURL) SetBasePath(bp string) {
 func (o *StartOrderingURL) Build() (*url.URL, error) {
 	var _result url.URL
 
-	var _path = "/system/start-ordering"
+	var _path = "/sessions/{sessionId}/system/start-ordering"
+
+	sessionID := o.SessionID
+	if sessionID != "" {
+		_path = strings.Replace(_path, "{sessionId}", sessionID, -1)
+	} else {
+		return nil, errors.New("sessionId is required on StartOrderingURL")
+	}
 
 	_basePath := o._basePath
 	if _basePath == "" {
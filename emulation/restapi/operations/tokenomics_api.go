@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"emulation/models"
 	"emulation/restapi/operations/tokenomics"
 )
 
@@ -44,42 +45,137 @@ func NewTokenomicsAPI(spec *loads.Document) *TokenomicsAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
-		TokenomicsCompleteCycleHandler: tokenomics.CompleteCycleHandlerFunc(func(params tokenomics.CompleteCycleParams) middleware.Responder {
+		AnalyzeConfigHandler: AnalyzeConfigHandlerFunc(func(params AnalyzeConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation AnalyzeConfig has not yet been implemented")
+		}),
+		AnalyzeSessionConfigHandler: AnalyzeSessionConfigHandlerFunc(func(params AnalyzeSessionConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation AnalyzeSessionConfig has not yet been implemented")
+		}),
+		TokenomicsCompleteCycleHandler: tokenomics.CompleteCycleHandlerFunc(func(params tokenomics.CompleteCycleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tokenomics.CompleteCycle has not yet been implemented")
 		}),
+		CreateSessionHandler: CreateSessionHandlerFunc(func(params CreateSessionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation CreateSession has not yet been implemented")
+		}),
+		DeleteSessionHandler: DeleteSessionHandlerFunc(func(params DeleteSessionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteSession has not yet been implemented")
+		}),
+		TokenomicsExportSnapshotHandler: tokenomics.ExportSnapshotHandlerFunc(func(params tokenomics.ExportSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tokenomics.ExportSnapshot has not yet been implemented")
+		}),
+		ForkSessionHandler: ForkSessionHandlerFunc(func(params ForkSessionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ForkSession has not yet been implemented")
+		}),
+		TokenomicsGetCommandLogHandler: tokenomics.GetCommandLogHandlerFunc(func(params tokenomics.GetCommandLogParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tokenomics.GetCommandLog has not yet been implemented")
+		}),
 		GetConfigHandler: GetConfigHandlerFunc(func(params GetConfigParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfig has not yet been implemented")
 		}),
+		GetMarketHistoryHandler: GetMarketHistoryHandlerFunc(func(params GetMarketHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetMarketHistory has not yet been implemented")
+		}),
+		GetMissedDeadlinesHandler: GetMissedDeadlinesHandlerFunc(func(params GetMissedDeadlinesParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetMissedDeadlines has not yet been implemented")
+		}),
 		GetOrderingAgentViewHandler: GetOrderingAgentViewHandlerFunc(func(params GetOrderingAgentViewParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetOrderingAgentView has not yet been implemented")
 		}),
 		GetProducingAgentViewHandler: GetProducingAgentViewHandlerFunc(func(params GetProducingAgentViewParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetProducingAgentView has not yet been implemented")
 		}),
+		GetRunHandler: GetRunHandlerFunc(func(params GetRunParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation GetRun has not yet been implemented")
+		}),
+		GetRunCyclesHandler: GetRunCyclesHandlerFunc(func(params GetRunCyclesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation GetRunCycles has not yet been implemented")
+		}),
+		GetRunEventsHandler: GetRunEventsHandlerFunc(func(params GetRunEventsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation GetRunEvents has not yet been implemented")
+		}),
+		GetScenarioHandler: GetScenarioHandlerFunc(func(params GetScenarioParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetScenario has not yet been implemented")
+		}),
+		GetSessionHandler: GetSessionHandlerFunc(func(params GetSessionParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetSession has not yet been implemented")
+		}),
 		GetSystemInfoHandler: GetSystemInfoHandlerFunc(func(params GetSystemInfoParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetSystemInfo has not yet been implemented")
 		}),
+		TokenomicsImportSnapshotHandler: tokenomics.ImportSnapshotHandlerFunc(func(params tokenomics.ImportSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tokenomics.ImportSnapshot has not yet been implemented")
+		}),
+		ListCheckpointsHandler: ListCheckpointsHandlerFunc(func(params ListCheckpointsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListCheckpoints has not yet been implemented")
+		}),
 		ListOrderingAgentsHandler: ListOrderingAgentsHandlerFunc(func(params ListOrderingAgentsParams) middleware.Responder {
 			return middleware.NotImplemented("operation ListOrderingAgents has not yet been implemented")
 		}),
 		ListProducingAgentsHandler: ListProducingAgentsHandlerFunc(func(params ListProducingAgentsParams) middleware.Responder {
 			return middleware.NotImplemented("operation ListProducingAgents has not yet been implemented")
 		}),
-		TokenomicsResetSystemHandler: tokenomics.ResetSystemHandlerFunc(func(params tokenomics.ResetSystemParams) middleware.Responder {
+		ListRunsHandler: ListRunsHandlerFunc(func(params ListRunsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ListRuns has not yet been implemented")
+		}),
+		ListScenariosHandler: ListScenariosHandlerFunc(func(params ListScenariosParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListScenarios has not yet been implemented")
+		}),
+		ListSessionsHandler: ListSessionsHandlerFunc(func(params ListSessionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListSessions has not yet been implemented")
+		}),
+		LoadScenarioHandler: LoadScenarioHandlerFunc(func(params LoadScenarioParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation LoadScenario has not yet been implemented")
+		}),
+		PassOrderingAgentHandler: PassOrderingAgentHandlerFunc(func(params PassOrderingAgentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation PassOrderingAgent has not yet been implemented")
+		}),
+		PassProducingAgentHandler: PassProducingAgentHandlerFunc(func(params PassProducingAgentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation PassProducingAgent has not yet been implemented")
+		}),
+		ResetGymHandler: ResetGymHandlerFunc(func(params ResetGymParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ResetGym has not yet been implemented")
+		}),
+		TokenomicsResetSystemHandler: tokenomics.ResetSystemHandlerFunc(func(params tokenomics.ResetSystemParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tokenomics.ResetSystem has not yet been implemented")
 		}),
-		SendOrderingAgentCommandHandler: SendOrderingAgentCommandHandlerFunc(func(params SendOrderingAgentCommandParams) middleware.Responder {
+		RevertConfigHandler: RevertConfigHandlerFunc(func(params RevertConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RevertConfig has not yet been implemented")
+		}),
+		RewindSessionHandler: RewindSessionHandlerFunc(func(params RewindSessionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RewindSession has not yet been implemented")
+		}),
+		SendOrderingAgentCommandHandler: SendOrderingAgentCommandHandlerFunc(func(params SendOrderingAgentCommandParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SendOrderingAgentCommand has not yet been implemented")
 		}),
-		SendProducingAgentCommandHandler: SendProducingAgentCommandHandlerFunc(func(params SendProducingAgentCommandParams) middleware.Responder {
+		SendProducingAgentCommandHandler: SendProducingAgentCommandHandlerFunc(func(params SendProducingAgentCommandParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SendProducingAgentCommand has not yet been implemented")
 		}),
-		TokenomicsStartOrderingHandler: tokenomics.StartOrderingHandlerFunc(func(params tokenomics.StartOrderingParams) middleware.Responder {
+		TokenomicsStartOrderingHandler: tokenomics.StartOrderingHandlerFunc(func(params tokenomics.StartOrderingParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tokenomics.StartOrdering has not yet been implemented")
 		}),
-		UpdateConfigHandler: UpdateConfigHandlerFunc(func(params UpdateConfigParams) middleware.Responder {
+		StepGymHandler: StepGymHandlerFunc(func(params StepGymParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation StepGym has not yet been implemented")
+		}),
+		StreamEventsHandler: StreamEventsHandlerFunc(func(params StreamEventsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation StreamEvents has not yet been implemented")
+		}),
+		StreamEventsWebSocketHandler: StreamEventsWebSocketHandlerFunc(func(params StreamEventsWebSocketParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation StreamEventsWebSocket has not yet been implemented")
+		}),
+		UpdateConfigHandler: UpdateConfigHandlerFunc(func(params UpdateConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation UpdateConfig has not yet been implemented")
 		}),
+		WaitPhaseHandler: WaitPhaseHandlerFunc(func(params WaitPhaseParams) middleware.Responder {
+			return middleware.NotImplemented("operation WaitPhase has not yet been implemented")
+		}),
+
+		// Applies when the "X-API-Key" header is set
+		APIKeyAuth: func(token string) (*models.Principal, error) {
+			return nil, errors.NotImplemented("api key auth (apiKey) X-API-Key from header param [X-API-Key] has not yet been implemented")
+		},
+
+		// default authorizer is authorized meaning no requirements
+		APIAuthorizer: security.Authorized(),
 	}
 }
 
@@ -116,30 +212,95 @@ type TokenomicsAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// APIKeyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key X-API-Key provided in the header
+	APIKeyAuth func(string) (*models.Principal, error)
+
+	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
+	APIAuthorizer runtime.Authorizer
+
+	// AnalyzeConfigHandler sets the operation handler for the analyze config operation
+	AnalyzeConfigHandler AnalyzeConfigHandler
+	// AnalyzeSessionConfigHandler sets the operation handler for the analyze session config operation
+	AnalyzeSessionConfigHandler AnalyzeSessionConfigHandler
 	// TokenomicsCompleteCycleHandler sets the operation handler for the complete cycle operation
 	TokenomicsCompleteCycleHandler tokenomics.CompleteCycleHandler
+	// CreateSessionHandler sets the operation handler for the create session operation
+	CreateSessionHandler CreateSessionHandler
+	// DeleteSessionHandler sets the operation handler for the delete session operation
+	DeleteSessionHandler DeleteSessionHandler
+	// TokenomicsExportSnapshotHandler sets the operation handler for the export snapshot operation
+	TokenomicsExportSnapshotHandler tokenomics.ExportSnapshotHandler
+	// ForkSessionHandler sets the operation handler for the fork session operation
+	ForkSessionHandler ForkSessionHandler
+	// TokenomicsGetCommandLogHandler sets the operation handler for the get command log operation
+	TokenomicsGetCommandLogHandler tokenomics.GetCommandLogHandler
 	// GetConfigHandler sets the operation handler for the get config operation
 	GetConfigHandler GetConfigHandler
+	// GetMarketHistoryHandler sets the operation handler for the get market history operation
+	GetMarketHistoryHandler GetMarketHistoryHandler
+	// GetMissedDeadlinesHandler sets the operation handler for the get missed deadlines operation
+	GetMissedDeadlinesHandler GetMissedDeadlinesHandler
 	// GetOrderingAgentViewHandler sets the operation handler for the get ordering agent view operation
 	GetOrderingAgentViewHandler GetOrderingAgentViewHandler
 	// GetProducingAgentViewHandler sets the operation handler for the get producing agent view operation
 	GetProducingAgentViewHandler GetProducingAgentViewHandler
+	// GetRunHandler sets the operation handler for the get run operation
+	GetRunHandler GetRunHandler
+	// GetRunCyclesHandler sets the operation handler for the get run cycles operation
+	GetRunCyclesHandler GetRunCyclesHandler
+	// GetRunEventsHandler sets the operation handler for the get run events operation
+	GetRunEventsHandler GetRunEventsHandler
+	// GetScenarioHandler sets the operation handler for the get scenario operation
+	GetScenarioHandler GetScenarioHandler
+	// GetSessionHandler sets the operation handler for the get session operation
+	GetSessionHandler GetSessionHandler
 	// GetSystemInfoHandler sets the operation handler for the get system info operation
 	GetSystemInfoHandler GetSystemInfoHandler
+	// TokenomicsImportSnapshotHandler sets the operation handler for the import snapshot operation
+	TokenomicsImportSnapshotHandler tokenomics.ImportSnapshotHandler
+	// ListCheckpointsHandler sets the operation handler for the list checkpoints operation
+	ListCheckpointsHandler ListCheckpointsHandler
 	// ListOrderingAgentsHandler sets the operation handler for the list ordering agents operation
 	ListOrderingAgentsHandler ListOrderingAgentsHandler
 	// ListProducingAgentsHandler sets the operation handler for the list producing agents operation
 	ListProducingAgentsHandler ListProducingAgentsHandler
+	// ListRunsHandler sets the operation handler for the list runs operation
+	ListRunsHandler ListRunsHandler
+	// ListScenariosHandler sets the operation handler for the list scenarios operation
+	ListScenariosHandler ListScenariosHandler
+	// ListSessionsHandler sets the operation handler for the list sessions operation
+	ListSessionsHandler ListSessionsHandler
+	// LoadScenarioHandler sets the operation handler for the load scenario operation
+	LoadScenarioHandler LoadScenarioHandler
+	// PassOrderingAgentHandler sets the operation handler for the pass ordering agent operation
+	PassOrderingAgentHandler PassOrderingAgentHandler
+	// PassProducingAgentHandler sets the operation handler for the pass producing agent operation
+	PassProducingAgentHandler PassProducingAgentHandler
+	// ResetGymHandler sets the operation handler for the reset gym operation
+	ResetGymHandler ResetGymHandler
 	// TokenomicsResetSystemHandler sets the operation handler for the reset system operation
 	TokenomicsResetSystemHandler tokenomics.ResetSystemHandler
+	// RevertConfigHandler sets the operation handler for the revert config operation
+	RevertConfigHandler RevertConfigHandler
+	// RewindSessionHandler sets the operation handler for the rewind session operation
+	RewindSessionHandler RewindSessionHandler
 	// SendOrderingAgentCommandHandler sets the operation handler for the send ordering agent command operation
 	SendOrderingAgentCommandHandler SendOrderingAgentCommandHandler
 	// SendProducingAgentCommandHandler sets the operation handler for the send producing agent command operation
 	SendProducingAgentCommandHandler SendProducingAgentCommandHandler
 	// TokenomicsStartOrderingHandler sets the operation handler for the start ordering operation
 	TokenomicsStartOrderingHandler tokenomics.StartOrderingHandler
+	// StepGymHandler sets the operation handler for the step gym operation
+	StepGymHandler StepGymHandler
+	// StreamEventsHandler sets the operation handler for the stream events operation
+	StreamEventsHandler StreamEventsHandler
+	// StreamEventsWebSocketHandler sets the operation handler for the stream events web socket operation
+	StreamEventsWebSocketHandler StreamEventsWebSocketHandler
 	// UpdateConfigHandler sets the operation handler for the update config operation
 	UpdateConfigHandler UpdateConfigHandler
+	// WaitPhaseHandler sets the operation handler for the wait phase operation
+	WaitPhaseHandler WaitPhaseHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -217,30 +378,109 @@ func (o *TokenomicsAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.APIKeyAuth == nil {
+		unregistered = append(unregistered, "XAPIKeyAuth")
+	}
+
+	if o.AnalyzeConfigHandler == nil {
+		unregistered = append(unregistered, "AnalyzeConfigHandler")
+	}
+	if o.AnalyzeSessionConfigHandler == nil {
+		unregistered = append(unregistered, "AnalyzeSessionConfigHandler")
+	}
 	if o.TokenomicsCompleteCycleHandler == nil {
 		unregistered = append(unregistered, "tokenomics.CompleteCycleHandler")
 	}
+	if o.CreateSessionHandler == nil {
+		unregistered = append(unregistered, "CreateSessionHandler")
+	}
+	if o.DeleteSessionHandler == nil {
+		unregistered = append(unregistered, "DeleteSessionHandler")
+	}
+	if o.TokenomicsExportSnapshotHandler == nil {
+		unregistered = append(unregistered, "tokenomics.ExportSnapshotHandler")
+	}
+	if o.ForkSessionHandler == nil {
+		unregistered = append(unregistered, "ForkSessionHandler")
+	}
+	if o.TokenomicsGetCommandLogHandler == nil {
+		unregistered = append(unregistered, "tokenomics.GetCommandLogHandler")
+	}
 	if o.GetConfigHandler == nil {
 		unregistered = append(unregistered, "GetConfigHandler")
 	}
+	if o.GetMarketHistoryHandler == nil {
+		unregistered = append(unregistered, "GetMarketHistoryHandler")
+	}
+	if o.GetMissedDeadlinesHandler == nil {
+		unregistered = append(unregistered, "GetMissedDeadlinesHandler")
+	}
 	if o.GetOrderingAgentViewHandler == nil {
 		unregistered = append(unregistered, "GetOrderingAgentViewHandler")
 	}
 	if o.GetProducingAgentViewHandler == nil {
 		unregistered = append(unregistered, "GetProducingAgentViewHandler")
 	}
+	if o.GetRunHandler == nil {
+		unregistered = append(unregistered, "GetRunHandler")
+	}
+	if o.GetRunCyclesHandler == nil {
+		unregistered = append(unregistered, "GetRunCyclesHandler")
+	}
+	if o.GetRunEventsHandler == nil {
+		unregistered = append(unregistered, "GetRunEventsHandler")
+	}
+	if o.GetScenarioHandler == nil {
+		unregistered = append(unregistered, "GetScenarioHandler")
+	}
+	if o.GetSessionHandler == nil {
+		unregistered = append(unregistered, "GetSessionHandler")
+	}
 	if o.GetSystemInfoHandler == nil {
 		unregistered = append(unregistered, "GetSystemInfoHandler")
 	}
+	if o.TokenomicsImportSnapshotHandler == nil {
+		unregistered = append(unregistered, "tokenomics.ImportSnapshotHandler")
+	}
+	if o.ListCheckpointsHandler == nil {
+		unregistered = append(unregistered, "ListCheckpointsHandler")
+	}
 	if o.ListOrderingAgentsHandler == nil {
 		unregistered = append(unregistered, "ListOrderingAgentsHandler")
 	}
 	if o.ListProducingAgentsHandler == nil {
 		unregistered = append(unregistered, "ListProducingAgentsHandler")
 	}
+	if o.ListRunsHandler == nil {
+		unregistered = append(unregistered, "ListRunsHandler")
+	}
+	if o.ListScenariosHandler == nil {
+		unregistered = append(unregistered, "ListScenariosHandler")
+	}
+	if o.ListSessionsHandler == nil {
+		unregistered = append(unregistered, "ListSessionsHandler")
+	}
+	if o.LoadScenarioHandler == nil {
+		unregistered = append(unregistered, "LoadScenarioHandler")
+	}
+	if o.PassOrderingAgentHandler == nil {
+		unregistered = append(unregistered, "PassOrderingAgentHandler")
+	}
+	if o.PassProducingAgentHandler == nil {
+		unregistered = append(unregistered, "PassProducingAgentHandler")
+	}
+	if o.ResetGymHandler == nil {
+		unregistered = append(unregistered, "ResetGymHandler")
+	}
 	if o.TokenomicsResetSystemHandler == nil {
 		unregistered = append(unregistered, "tokenomics.ResetSystemHandler")
 	}
+	if o.RevertConfigHandler == nil {
+		unregistered = append(unregistered, "RevertConfigHandler")
+	}
+	if o.RewindSessionHandler == nil {
+		unregistered = append(unregistered, "RewindSessionHandler")
+	}
 	if o.SendOrderingAgentCommandHandler == nil {
 		unregistered = append(unregistered, "SendOrderingAgentCommandHandler")
 	}
@@ -250,9 +490,21 @@ func (o *TokenomicsAPI) Validate() error {
 	if o.TokenomicsStartOrderingHandler == nil {
 		unregistered = append(unregistered, "tokenomics.StartOrderingHandler")
 	}
+	if o.StepGymHandler == nil {
+		unregistered = append(unregistered, "StepGymHandler")
+	}
+	if o.StreamEventsHandler == nil {
+		unregistered = append(unregistered, "StreamEventsHandler")
+	}
+	if o.StreamEventsWebSocketHandler == nil {
+		unregistered = append(unregistered, "StreamEventsWebSocketHandler")
+	}
 	if o.UpdateConfigHandler == nil {
 		unregistered = append(unregistered, "UpdateConfigHandler")
 	}
+	if o.WaitPhaseHandler == nil {
+		unregistered = append(unregistered, "WaitPhaseHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -268,12 +520,23 @@ func (o *TokenomicsAPI) ServeErrorFor(operationID string) func(http.ResponseWrit
 
 // AuthenticatorsFor gets the authenticators for the specified security schemes
 func (o *TokenomicsAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
-	return nil
+	result := make(map[string]runtime.Authenticator)
+	for name := range schemes {
+		switch name {
+		case "apiKey":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, func(token string) (interface{}, error) {
+				return o.APIKeyAuth(token)
+			})
+
+		}
+	}
+	return result
 }
 
 // Authorizer returns the registered authorizer
 func (o *TokenomicsAPI) Authorizer() runtime.Authorizer {
-	return nil
+	return o.APIAuthorizer
 }
 
 // ConsumersFor gets the consumers for the specified media types.
@@ -344,51 +607,167 @@ func (o *TokenomicsAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
-	o.handlers["POST"]["/system/complete-cycle"] = tokenomics.NewCompleteCycle(o.context, o.TokenomicsCompleteCycleHandler)
+	o.handlers["POST"]["/config/analyze"] = NewAnalyzeConfig(o.context, o.AnalyzeConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/config"] = NewGetConfig(o.context, o.GetConfigHandler)
+	o.handlers["GET"]["/sessions/{sessionId}/config/analyze"] = NewAnalyzeSessionConfig(o.context, o.AnalyzeSessionConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions/{sessionId}/system/complete-cycle"] = tokenomics.NewCompleteCycle(o.context, o.TokenomicsCompleteCycleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions"] = NewCreateSession(o.context, o.CreateSessionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/sessions/{sessionId}"] = NewDeleteSession(o.context, o.DeleteSessionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/ordering-agents/{id}"] = NewGetOrderingAgentView(o.context, o.GetOrderingAgentViewHandler)
+	o.handlers["GET"]["/sessions/{sessionId}/system/snapshot"] = tokenomics.NewExportSnapshot(o.context, o.TokenomicsExportSnapshotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions/{sessionId}/fork"] = NewForkSession(o.context, o.ForkSessionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/system/commands"] = tokenomics.NewGetCommandLog(o.context, o.TokenomicsGetCommandLogHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/config"] = NewGetConfig(o.context, o.GetConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/market/history"] = NewGetMarketHistory(o.context, o.GetMarketHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/system/missed-deadlines"] = NewGetMissedDeadlines(o.context, o.GetMissedDeadlinesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/ordering-agents/{id}"] = NewGetOrderingAgentView(o.context, o.GetOrderingAgentViewHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/producing-agents/{id}"] = NewGetProducingAgentView(o.context, o.GetProducingAgentViewHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/runs/{runId}"] = NewGetRun(o.context, o.GetRunHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/runs/{runId}/cycles"] = NewGetRunCycles(o.context, o.GetRunCyclesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/runs/{runId}/events"] = NewGetRunEvents(o.context, o.GetRunEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/scenarios/{name}"] = NewGetScenario(o.context, o.GetScenarioHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}"] = NewGetSession(o.context, o.GetSessionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/system"] = NewGetSystemInfo(o.context, o.GetSystemInfoHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/sessions/{sessionId}/system/snapshot"] = tokenomics.NewImportSnapshot(o.context, o.TokenomicsImportSnapshotHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/checkpoints"] = NewListCheckpoints(o.context, o.ListCheckpointsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/producing-agents/{id}"] = NewGetProducingAgentView(o.context, o.GetProducingAgentViewHandler)
+	o.handlers["GET"]["/sessions/{sessionId}/ordering-agents"] = NewListOrderingAgents(o.context, o.ListOrderingAgentsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/system"] = NewGetSystemInfo(o.context, o.GetSystemInfoHandler)
+	o.handlers["GET"]["/sessions/{sessionId}/producer-agents"] = NewListProducingAgents(o.context, o.ListProducingAgentsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/ordering-agents"] = NewListOrderingAgents(o.context, o.ListOrderingAgentsHandler)
+	o.handlers["GET"]["/runs"] = NewListRuns(o.context, o.ListRunsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/producer-agents"] = NewListProducingAgents(o.context, o.ListProducingAgentsHandler)
+	o.handlers["GET"]["/scenarios"] = NewListScenarios(o.context, o.ListScenariosHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions"] = NewListSessions(o.context, o.ListSessionsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions/{sessionId}/scenario"] = NewLoadScenario(o.context, o.LoadScenarioHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions/{sessionId}/ordering-agents/{id}/pass"] = NewPassOrderingAgent(o.context, o.PassOrderingAgentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions/{sessionId}/producing-agents/{id}/pass"] = NewPassProducingAgent(o.context, o.PassProducingAgentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions/{sessionId}/gym/reset"] = NewResetGym(o.context, o.ResetGymHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
-	o.handlers["DELETE"]["/system"] = tokenomics.NewResetSystem(o.context, o.TokenomicsResetSystemHandler)
+	o.handlers["DELETE"]["/sessions/{sessionId}/system"] = tokenomics.NewResetSystem(o.context, o.TokenomicsResetSystemHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/sessions/{sessionId}/config"] = NewRevertConfig(o.context, o.RevertConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions/{sessionId}/rewind"] = NewRewindSession(o.context, o.RewindSessionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions/{sessionId}/ordering-agents/{id}"] = NewSendOrderingAgentCommand(o.context, o.SendOrderingAgentCommandHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
-	o.handlers["POST"]["/ordering-agents/{id}"] = NewSendOrderingAgentCommand(o.context, o.SendOrderingAgentCommandHandler)
+	o.handlers["POST"]["/sessions/{sessionId}/producing-agents/{id}"] = NewSendProducingAgentCommand(o.context, o.SendProducingAgentCommandHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
-	o.handlers["POST"]["/producing-agents/{id}"] = NewSendProducingAgentCommand(o.context, o.SendProducingAgentCommandHandler)
+	o.handlers["POST"]["/sessions/{sessionId}/system/start-ordering"] = tokenomics.NewStartOrdering(o.context, o.TokenomicsStartOrderingHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
-	o.handlers["POST"]["/system/start-ordering"] = tokenomics.NewStartOrdering(o.context, o.TokenomicsStartOrderingHandler)
+	o.handlers["POST"]["/sessions/{sessionId}/gym/step"] = NewStepGym(o.context, o.StepGymHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/events"] = NewStreamEvents(o.context, o.StreamEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/events/ws"] = NewStreamEventsWebSocket(o.context, o.StreamEventsWebSocketHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
-	o.handlers["PUT"]["/config"] = NewUpdateConfig(o.context, o.UpdateConfigHandler)
+	o.handlers["PUT"]["/sessions/{sessionId}/config"] = NewUpdateConfig(o.context, o.UpdateConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{sessionId}/system/wait"] = NewWaitPhase(o.context, o.WaitPhaseHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
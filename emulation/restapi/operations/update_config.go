@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"emulation/models"
 )
 
 // UpdateConfigHandlerFunc turns a function with the right signature into a update config handler
-type UpdateConfigHandlerFunc func(UpdateConfigParams) middleware.Responder
+type UpdateConfigHandlerFunc func(UpdateConfigParams, *models.Principal) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn UpdateConfigHandlerFunc) Handle(params UpdateConfigParams) middleware.Responder {
-	return fn(params)
+func (fn UpdateConfigHandlerFunc) Handle(params UpdateConfigParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
 }
 
 // UpdateConfigHandler interface for that can handle valid update config params
 type UpdateConfigHandler interface {
-	Handle(UpdateConfigParams) middleware.Responder
+	Handle(UpdateConfigParams, *models.Principal) middleware.Responder
 }
 
 // NewUpdateConfig creates a new http.Handler for the update config operation
@@ -30,9 +32,11 @@ func NewUpdateConfig(ctx *middleware.Context, handler UpdateConfigHandler) *Upda
 }
 
 /*
-	UpdateConfig swagger:route PUT /config updateConfig
+	UpdateConfig swagger:route PUT /sessions/{sessionId}/config updateConfig
+
+# Update system configuration
 
-Update system configuration
+The configuration takes effect on the next reset, or at once when applied. An invalid configuration, including unknown keys, bot strategies and default actions, is rejected and the current one is kept.
 */
 type UpdateConfig struct {
 	Context *middleware.Context
@@ -45,12 +49,25 @@ func (o *UpdateConfig) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewUpdateConfigParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -60,3 +60,78 @@ func (o *UpdateConfigBadRequest) WriteResponse(rw http.ResponseWriter, producer
 
 	rw.WriteHeader(400)
 }
+
+// UpdateConfigUnauthorizedCode is the HTTP code returned for type UpdateConfigUnauthorized
+const UpdateConfigUnauthorizedCode int = 401
+
+/*
+UpdateConfigUnauthorized Missing or unknown API key
+
+swagger:response updateConfigUnauthorized
+*/
+type UpdateConfigUnauthorized struct {
+}
+
+// NewUpdateConfigUnauthorized creates UpdateConfigUnauthorized with default headers values
+func NewUpdateConfigUnauthorized() *UpdateConfigUnauthorized {
+
+	return &UpdateConfigUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *UpdateConfigUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// UpdateConfigForbiddenCode is the HTTP code returned for type UpdateConfigForbidden
+const UpdateConfigForbiddenCode int = 403
+
+/*
+UpdateConfigForbidden The API key has no admin role
+
+swagger:response updateConfigForbidden
+*/
+type UpdateConfigForbidden struct {
+}
+
+// NewUpdateConfigForbidden creates UpdateConfigForbidden with default headers values
+func NewUpdateConfigForbidden() *UpdateConfigForbidden {
+
+	return &UpdateConfigForbidden{}
+}
+
+// WriteResponse to the client
+func (o *UpdateConfigForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(403)
+}
+
+// UpdateConfigNotFoundCode is the HTTP code returned for type UpdateConfigNotFound
+const UpdateConfigNotFoundCode int = 404
+
+/*
+UpdateConfigNotFound Session not found
+
+swagger:response updateConfigNotFound
+*/
+type UpdateConfigNotFound struct {
+}
+
+// NewUpdateConfigNotFound creates UpdateConfigNotFound with default headers values
+func NewUpdateConfigNotFound() *UpdateConfigNotFound {
+
+	return &UpdateConfigNotFound{}
+}
+
+// WriteResponse to the client
+func (o *UpdateConfigNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
@@ -9,11 +9,20 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // UpdateConfigURL generates an URL for the update config operation
 type UpdateConfigURL struct {
+	SessionID string
+
+	Apply *bool
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -35,7 +44,14 @@ func (o *UpdateConfigURL) SetBasePath(bp string) {
 func (o *UpdateConfigURL) Build() (*url.URL, error) {
 	var _result url.URL
 
-	var _path = "/config"
+	var _path = "/sessions/{sessionId}/config"
+
+	sessionID := o.SessionID
+	if sessionID != "" {
+		_path = strings.Replace(_path, "{sessionId}", sessionID, -1)
+	} else {
+		return nil, errors.New("sessionId is required on UpdateConfigURL")
+	}
 
 	_basePath := o._basePath
 	if _basePath == "" {
@@ -43,6 +59,18 @@ func (o *UpdateConfigURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var applyQ string
+	if o.Apply != nil {
+		applyQ = swag.FormatBool(*o.Apply)
+	}
+	if applyQ != "" {
+		qs.Set("apply", applyQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
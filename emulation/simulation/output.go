@@ -0,0 +1,40 @@
+package simulation
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/samber/lo"
+)
+
+var csvHeader = []string{"cycle", "score", "capacity", "maxCapacity", "requestedCapacity", "acceptedCapacity", "rejectedCapacity", "funds"}
+
+// WriteJSON writes the summary and the per-cycle records as a single JSON document
+func WriteJSON(w io.Writer, result Result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
+
+// WriteCSV writes the per-cycle records, one row per cycle
+func WriteCSV(w io.Writer, records []CycleRecord) error {
+	cw := csv.NewWriter(w)
+	rows := lo.Map(records, func(r CycleRecord, _ int) []string {
+		return []string{
+			strconv.FormatUint(uint64(r.Cycle), 10),
+			strconv.FormatUint(uint64(r.Score), 10),
+			strconv.Itoa(int(r.Capacity)),
+			strconv.Itoa(int(r.MaxCapacity)),
+			strconv.Itoa(int(r.RequestedCapacity)),
+			strconv.Itoa(int(r.AcceptedCapacity)),
+			strconv.Itoa(int(r.RejectedCapacity)),
+			strconv.FormatUint(uint64(r.Funds), 10),
+		}
+	})
+	if err := cw.WriteAll(append([][]string{csvHeader}, rows...)); err != nil {
+		return err
+	}
+	return cw.Error()
+}
@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// ForEach calls f for every index within [0, n) using up to workers goroutines,
+// GOMAXPROCS goroutines when workers is not positive. All errors are returned joined.
+func ForEach(n, workers int, f func(i int) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	errs := make([]error, n)
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = f(i)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,117 @@
+package simulation
+
+import (
+	"fmt"
+
+	"emulation/domain"
+	"emulation/strategy"
+
+	"github.com/samber/lo"
+)
+
+// Options of a headless run
+type Options struct {
+	Cycles uint
+	Seed   uint64
+	// OnCycle, if set, is called with the result of every completed cycle
+	OnCycle func(cycle uint, result domain.CycleResult)
+	// Events, if set, receives the domain events of the run
+	Events *domain.EventBus
+}
+
+// CycleRecord is the outcome of a single cycle summed over all producers
+type CycleRecord struct {
+	Cycle             uint            `json:"cycle"`
+	Score             domain.Score    `json:"score"`
+	Capacity          domain.Capacity `json:"capacity"`
+	MaxCapacity       domain.Capacity `json:"maxCapacity"`
+	RequestedCapacity domain.Capacity `json:"requestedCapacity"`
+	AcceptedCapacity  domain.Capacity `json:"acceptedCapacity"`
+	RejectedCapacity  domain.Capacity `json:"rejectedCapacity"`
+	Funds             domain.Tokens   `json:"funds"`
+}
+
+// Summary is the outcome of the whole run
+type Summary struct {
+	Cycles           uint            `json:"cycles"`
+	Seed             uint64          `json:"seed"`
+	TotalScore       domain.Score    `json:"totalScore"`
+	MeanScore        float64         `json:"meanScore"`
+	AcceptedShare    float64         `json:"acceptedShare"`
+	FinalCapacity    domain.Capacity `json:"finalCapacity"`
+	FinalMaxCapacity domain.Capacity `json:"finalMaxCapacity"`
+}
+
+type Result struct {
+	Summary Summary       `json:"summary"`
+	Cycles  []CycleRecord `json:"cycles"`
+}
+
+// Run plays the configured number of cycles with all agents driven by the configured bots.
+// Agents without a bot stay idle, so their orders are never placed.
+func Run(config *domain.Configuration, opts Options) (Result, error) {
+	bots, err := strategy.NewBots(config)
+	if err != nil {
+		return Result{}, err
+	}
+	var idGen domain.SequentialIdGenerator
+	system := domain.NewSystem(&idGen, config, domain.NewConsumers(config.Consumers, opts.Seed), opts.Events)
+
+	records := make([]CycleRecord, 0, opts.Cycles)
+	for range opts.Cycles {
+		if err := bots.Invest(system); err != nil {
+			return Result{}, err
+		}
+		if err := system.StartOrdering(); err != nil {
+			return Result{}, err
+		}
+		if err := bots.Order(system); err != nil {
+			return Result{}, err
+		}
+		cycle := system.GetSystemInfo().CycleCounter
+		result, err := system.CompleteCycle()
+		if err != nil {
+			return Result{}, fmt.Errorf("cycle [%d]: %w", cycle, err)
+		}
+		if opts.OnCycle != nil {
+			opts.OnCycle(cycle, result)
+		}
+		records = append(records, newCycleRecord(cycle, result, system))
+	}
+	return Result{summarize(records, opts), records}, nil
+}
+
+func newCycleRecord(cycle uint, result domain.CycleResult, system *domain.System) CycleRecord {
+	record := CycleRecord{Cycle: cycle, Score: result.Score}
+	for _, p := range system.GetProducerInfos() {
+		record.Capacity += p.Capacity
+		record.MaxCapacity += p.MaxCapacity
+	}
+	for _, m := range system.MarketHistory(cycle, cycle) {
+		record.RequestedCapacity += m.RequestedCapacity
+		record.AcceptedCapacity += m.AcceptedCapacity
+		record.RejectedCapacity += m.RejectedCapacity
+		record.Funds += m.Funds
+	}
+	return record
+}
+
+func summarize(records []CycleRecord, opts Options) Summary {
+	summary := Summary{
+		Cycles:     uint(len(records)),
+		Seed:       opts.Seed,
+		TotalScore: lo.SumBy(records, func(r CycleRecord) domain.Score { return r.Score }),
+	}
+	if len(records) == 0 {
+		return summary
+	}
+	summary.MeanScore = float64(summary.TotalScore) / float64(len(records))
+	requested := lo.SumBy(records, func(r CycleRecord) domain.Capacity { return r.RequestedCapacity })
+	if requested > 0 {
+		accepted := lo.SumBy(records, func(r CycleRecord) domain.Capacity { return r.AcceptedCapacity })
+		summary.AcceptedShare = float64(accepted) / float64(requested)
+	}
+	last := records[len(records)-1]
+	summary.FinalCapacity, summary.FinalMaxCapacity = last.Capacity, last.MaxCapacity
+	return summary
+}
@@ -0,0 +1,72 @@
+package simulation
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"emulation/domain"
+	"emulation/domain/domaintest"
+	"emulation/strategy"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig is the shared economy with an upgrading producer and all agents controlled by bots
+func testConfig() *domain.Configuration {
+	config := domaintest.Economy()
+	config.ProducerConfigs[0].Upgrade = domain.Upgrade{Require: 2, Increases: 20}
+	config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{
+		"c1": {Name: strategy.ProportionalToCapacityName},
+		"c2": {Name: strategy.FixedMarkupName},
+		"p1": {Name: strategy.ProportionalToPriceName},
+		"p2": {Name: strategy.ProportionalToPriceName},
+	}
+	config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{
+		"p1": {Name: strategy.ThresholdName},
+		"p2": {Name: strategy.RestoreBelowName},
+	}
+	return config
+}
+
+func TestRun(t *testing.T) {
+	t.Run(`Given a configuration with all agents controlled by bots
+		When it is run twice with the same seed
+		Then the results are the same`, func(t *testing.T) {
+
+		config := testConfig()
+		require.NoError(t, config.Validate())
+
+		first, err := Run(config, Options{Cycles: 50, Seed: 3})
+		require.NoError(t, err)
+		second, err := Run(config, Options{Cycles: 50, Seed: 3})
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+
+		require.Len(t, first.Cycles, 50)
+		require.Equal(t, uint(1), first.Cycles[0].Cycle)
+		require.Equal(t, uint(50), first.Summary.Cycles)
+		require.Equal(t, first.Cycles[49].Capacity, first.Summary.FinalCapacity)
+		require.Greater(t, first.Summary.AcceptedShare, 0.0)
+	})
+
+	t.Run(`Given an unknown strategy
+		When it is run
+		Then an error is returned`, func(t *testing.T) {
+
+		config := testConfig()
+		config.OrderingBots["c1"] = domain.StrategyConfig{Name: "unknown"}
+		_, err := Run(config, Options{Cycles: 1})
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, []CycleRecord{{1, 2, 3, 4, 5, 6, 7, 8}})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"cycle,score,capacity,maxCapacity,requestedCapacity,acceptedCapacity,rejectedCapacity,funds",
+		"1,2,3,4,5,6,7,8",
+	}, strings.Split(strings.TrimSpace(buf.String()), "\n"))
+}
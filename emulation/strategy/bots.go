@@ -0,0 +1,86 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"emulation/domain"
+)
+
+// System is the part of domain.System the bots command
+type System interface {
+	ProducingAgentView(id domain.ProducerId) (domain.ProducingAgentView, error)
+	ProducingAgentAction(id domain.ProducerId, cmd domain.ProducingAgentCommand) error
+	OrderingAgentView(id domain.OrderingAgentId) (domain.OrderingAgentView, error)
+	OrderingAgentAction(id domain.OrderingAgentId, cmd domain.OrderingAgentCommand) error
+}
+
+// Bots issues the commands of the agents marked as bot-controlled in the configuration
+type Bots struct {
+	ordering  map[domain.OrderingAgentId]OrderingStrategy
+	producing map[domain.ProducerId]ProducingStrategy
+}
+
+func NewBots(config *domain.Configuration) (*Bots, error) {
+	ordering := make(map[domain.OrderingAgentId]OrderingStrategy, len(config.OrderingBots))
+	for agentId, strategyConfig := range config.OrderingBots {
+		strategy, err := NewOrderingStrategy(strategyConfig)
+		if err != nil {
+			return nil, fmt.Errorf("ordering bot [%s]: %w", agentId, err)
+		}
+		ordering[agentId] = strategy
+	}
+	producing := make(map[domain.ProducerId]ProducingStrategy, len(config.ProducingBots))
+	for producerId, strategyConfig := range config.ProducingBots {
+		strategy, err := NewProducingStrategy(strategyConfig)
+		if err != nil {
+			return nil, fmt.Errorf("producing bot [%s]: %w", producerId, err)
+		}
+		producing[producerId] = strategy
+	}
+	return &Bots{ordering, producing}, nil
+}
+
+// Invest sends the commands of all producing bots. The system must be in the orders placement state.
+// A failing bot doesn't stop the others, all errors are returned joined.
+func (b *Bots) Invest(s System) error {
+	var errs []error
+	for _, producerId := range sortedKeys(b.producing) {
+		view, err := s.ProducingAgentView(producerId)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("producing bot [%s]: %w", producerId, err))
+			continue
+		}
+		cmd := b.producing[producerId].Command(view)
+		if err := s.ProducingAgentAction(producerId, cmd); err != nil {
+			errs = append(errs, fmt.Errorf("producing bot [%s]: %w", producerId, err))
+			continue
+		}
+		slog.Info("bots.producing.commanded",
+			slog.String("producerId", string(producerId)),
+			slog.Bool("doUpgrade", cmd.DoUpgrade),
+			slog.Bool("doRestoration", cmd.DoRestoration))
+	}
+	return errors.Join(errs...)
+}
+
+// Order sends the commands of all ordering bots. The system must be in the ordering state.
+// A failing bot doesn't stop the others, all errors are returned joined.
+func (b *Bots) Order(s System) error {
+	var errs []error
+	for _, agentId := range sortedKeys(b.ordering) {
+		view, err := s.OrderingAgentView(agentId)
+		if err == nil {
+			err = s.OrderingAgentAction(agentId, b.ordering[agentId].Command(view))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ordering bot [%s]: %w", agentId, err))
+			continue
+		}
+		slog.Info("bots.ordering.commanded",
+			slog.String("agentId", string(agentId)),
+			slog.Int("orders", len(view.Incoming)))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,232 @@
+package strategy
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"emulation/domain"
+
+	"github.com/samber/lo"
+)
+
+const (
+	ProportionalToCapacityName = "proportional-capacity"
+	ProportionalToPriceName    = "proportional-price"
+	FixedMarkupName            = "fixed-markup"
+)
+
+// OrderingStrategy decides how an ordering agent spends the tokens of its incoming orders
+type OrderingStrategy interface {
+	Command(view domain.OrderingAgentView) domain.OrderingAgentCommand
+}
+
+// NewOrderingStrategy creates the built-in ordering strategy selected by the config
+func NewOrderingStrategy(config domain.StrategyConfig) (OrderingStrategy, error) {
+	switch config.Name {
+	case ProportionalToCapacityName:
+		if err := checkParams(config); err != nil {
+			return nil, err
+		}
+		return ProportionalToCapacity{}, nil
+	case ProportionalToPriceName:
+		if err := checkParams(config); err != nil {
+			return nil, err
+		}
+		return ProportionalToPrice{}, nil
+	case FixedMarkupName:
+		if err := checkParams(config, "markup"); err != nil {
+			return nil, err
+		}
+		markup := paramOr(config.Params, "markup", 0.1)
+		if markup < 0 {
+			return nil, fmt.Errorf("strategy [%s]: markup must not be negative, got %v", config.Name, markup)
+		}
+		return FixedMarkup{markup}, nil
+	default:
+		return nil, fmt.Errorf("%w: ordering strategy [%s]", domain.ErrNotFound, config.Name)
+	}
+}
+
+// ProportionalToCapacity splits an order's tokens proportionally to the capacity it requires of each type
+type ProportionalToCapacity struct{}
+
+func (ProportionalToCapacity) Command(view domain.OrderingAgentView) domain.OrderingAgentCommand {
+	return command(view, func(tokens domain.Tokens, parts map[domain.CapacityType]orderPart) map[domain.CapacityType]domain.Tokens {
+		return split(tokens, capacityWeights(parts))
+	})
+}
+
+func capacityWeights(parts map[domain.CapacityType]orderPart) map[domain.CapacityType]float64 {
+	return lo.MapValues(parts, func(p orderPart, _ domain.CapacityType) float64 {
+		return float64(p.required)
+	})
+}
+
+// ProportionalToPrice splits an order's tokens proportionally to the last cut-off price of the required capacity.
+// Producers without a price yet are assumed to sell at the average known price.
+type ProportionalToPrice struct{}
+
+func (ProportionalToPrice) Command(view domain.OrderingAgentView) domain.OrderingAgentCommand {
+	return command(view, func(tokens domain.Tokens, parts map[domain.CapacityType]orderPart) map[domain.CapacityType]domain.Tokens {
+		fallback := averagePrice(view.Producers)
+		return split(tokens, lo.MapValues(parts, func(p orderPart, _ domain.CapacityType) float64 {
+			price := p.producer.CutOffPrice
+			if price.IsNaN() {
+				price = fallback
+			}
+			return float64(price) * float64(p.required)
+		}))
+	})
+}
+
+// FixedMarkup bids the last cut-off price plus a fixed markup on every capacity with a known price.
+// The rest of the budget goes to the capacities without a price, or to the largest one when all are priced.
+// When the budget is short the marked-up bids are scaled down proportionally.
+type FixedMarkup struct {
+	Markup float64
+}
+
+func (f FixedMarkup) Command(view domain.OrderingAgentView) domain.OrderingAgentCommand {
+	return command(view, func(tokens domain.Tokens, parts map[domain.CapacityType]orderPart) map[domain.CapacityType]domain.Tokens {
+		priced := map[domain.CapacityType]float64{}
+		unpriced := map[domain.CapacityType]float64{}
+		for ct, p := range parts {
+			if p.producer.CutOffPrice.IsNaN() {
+				unpriced[ct] = float64(p.required)
+				continue
+			}
+			priced[ct] = math.Ceil(float64(p.producer.CutOffPrice) * (1 + f.Markup) * float64(p.required))
+		}
+		if len(priced) == 0 {
+			return split(tokens, unpriced)
+		}
+		total := lo.Sum(lo.Values(priced))
+		if total >= float64(tokens) {
+			result := split(tokens, priced)
+			for ct := range unpriced {
+				result[ct] = 0
+			}
+			return result
+		}
+		result := lo.MapValues(priced, func(bid float64, _ domain.CapacityType) domain.Tokens {
+			return domain.Tokens(bid)
+		})
+		rest := tokens - lo.Sum(lo.Values(result))
+		if len(unpriced) == 0 {
+			largest := slices.MaxFunc(sortedKeys(parts), func(a, b domain.CapacityType) int {
+				return cmp.Compare(parts[a].required, parts[b].required)
+			})
+			result[largest] += rest
+			return result
+		}
+		for ct, bid := range split(rest, unpriced) {
+			result[ct] = bid
+		}
+		return result
+	})
+}
+
+// orderPart is the capacity of a single type an order requires and the producer chosen to supply it
+type orderPart struct {
+	producer domain.ProducerInfo
+	required domain.Capacity
+}
+
+type allocation func(tokens domain.Tokens, parts map[domain.CapacityType]orderPart) map[domain.CapacityType]domain.Tokens
+
+// command bids for every incoming order at the producer with the most capacity of each required type
+func command(view domain.OrderingAgentView, allocate allocation) domain.OrderingAgentCommand {
+	return commandWith(view, pickProducer, allocate)
+}
+
+// commandWith bids for every incoming order at the producers chosen by pick for each required type
+func commandWith(view domain.OrderingAgentView, pick func(map[domain.ProducerId]domain.ProducerInfo) domain.ProducerInfo, allocate allocation) domain.OrderingAgentCommand {
+	orders := make(map[domain.OrderId]map[domain.ProducerId]domain.Tokens, len(view.Incoming))
+	for orderId, required := range view.Incoming {
+		parts := lo.MapValues(required, func(capacity domain.Capacity, ct domain.CapacityType) orderPart {
+			return orderPart{pick(view.Producers[ct]), capacity}
+		})
+		orders[orderId] = lo.MapKeys(allocate(view.Tokens[orderId], parts), func(_ domain.Tokens, ct domain.CapacityType) domain.ProducerId {
+			return parts[ct].producer.Id
+		})
+	}
+	return domain.OrderingAgentCommand{Orders: orders}
+}
+
+func pickProducer(producers map[domain.ProducerId]domain.ProducerInfo) domain.ProducerInfo {
+	var result domain.ProducerInfo
+	for _, id := range sortedKeys(producers) {
+		if p := producers[id]; result.Id == "" || p.Capacity > result.Capacity {
+			result = p
+		}
+	}
+	return result
+}
+
+func averagePrice(producers map[domain.CapacityType]map[domain.ProducerId]domain.ProducerInfo) domain.CapacityUnitPrice {
+	var sum domain.CapacityUnitPrice
+	count := 0
+	for _, byId := range producers {
+		for _, p := range byId {
+			if !p.CutOffPrice.IsNaN() {
+				sum += p.CutOffPrice
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return 1
+	}
+	return sum / domain.CapacityUnitPrice(count)
+}
+
+// split divides tokens proportionally to the weights so that the shares sum up to tokens exactly.
+// The remainder left after rounding down goes to the largest fractional parts.
+func split(tokens domain.Tokens, weights map[domain.CapacityType]float64) map[domain.CapacityType]domain.Tokens {
+	keys := sortedKeys(weights)
+	total := lo.Sum(lo.Values(weights))
+	if total <= 0 {
+		weights = lo.MapValues(weights, func(float64, domain.CapacityType) float64 { return 1 })
+		total = float64(len(weights))
+	}
+	result := make(map[domain.CapacityType]domain.Tokens, len(weights))
+	fractions := make(map[domain.CapacityType]float64, len(weights))
+	rest := tokens
+	for _, ct := range keys {
+		share := float64(tokens) * weights[ct] / total
+		result[ct] = min(domain.Tokens(share), rest)
+		fractions[ct] = share - math.Floor(share)
+		rest -= result[ct]
+	}
+	slices.SortStableFunc(keys, func(a, b domain.CapacityType) int {
+		return cmp.Compare(fractions[b], fractions[a])
+	})
+	for i := 0; rest > 0 && len(keys) > 0; i = (i + 1) % len(keys) {
+		result[keys[i]]++
+		rest--
+	}
+	return result
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	return slices.Sorted(maps.Keys(m))
+}
+
+func paramOr(params map[string]float64, name string, def float64) float64 {
+	if v, ok := params[name]; ok {
+		return v
+	}
+	return def
+}
+
+func checkParams(config domain.StrategyConfig, known ...string) error {
+	for name := range config.Params {
+		if !slices.Contains(known, name) {
+			return fmt.Errorf("strategy [%s]: unknown parameter [%s]", config.Name, name)
+		}
+	}
+	return nil
+}
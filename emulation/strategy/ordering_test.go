@@ -0,0 +1,222 @@
+package strategy
+
+import (
+	"strconv"
+	"testing"
+
+	"emulation/domain"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+type testConsumer struct {
+	id     domain.ConsumerId
+	tokens domain.Tokens
+}
+
+// Emit implements domain.Consumer.
+func (t *testConsumer) Emit(val domain.Tokens) {
+	t.tokens = val
+}
+
+// Id implements domain.Consumer.
+func (t *testConsumer) Id() domain.ConsumerId {
+	return t.id
+}
+
+// Order implements domain.Consumer.
+func (t *testConsumer) Order() []domain.ConsumerRequest {
+	return []domain.ConsumerRequest{{ConsumerId: t.id, Product: 1, Tokens: t.tokens}}
+}
+
+var _ domain.Consumer = &testConsumer{}
+
+type testIdGenerator struct {
+	val int
+}
+
+// New implements domain.OrderIdGenerator.
+func (t *testIdGenerator) New() domain.OrderId {
+	id := domain.OrderId(strconv.Itoa(t.val))
+	t.val++
+	return id
+}
+
+func testView(cutOff1, cutOff2 domain.CapacityUnitPrice) domain.OrderingAgentView {
+	return domain.OrderingAgentView{
+		Incoming: map[domain.OrderId]map[domain.CapacityType]domain.Capacity{
+			"o1": {"1": 10, "2": 30},
+		},
+		Tokens: map[domain.OrderId]domain.Tokens{"o1": 101},
+		Producers: map[domain.CapacityType]map[domain.ProducerId]domain.ProducerInfo{
+			"1": {
+				"p1": {Id: "p1", CapacityType: "1", MaxCapacity: 100, Capacity: 50, CutOffPrice: cutOff1},
+				"p3": {Id: "p3", CapacityType: "1", MaxCapacity: 100, Capacity: 80, CutOffPrice: cutOff1},
+			},
+			"2": {
+				"p2": {Id: "p2", CapacityType: "2", MaxCapacity: 100, Capacity: 100, CutOffPrice: cutOff2},
+			},
+		},
+	}
+}
+
+func TestSplit(t *testing.T) {
+	t.Run(`Given weights which don't divide the tokens evenly
+		When the tokens are split
+		Then the shares sum up to the tokens
+		And the remainder goes to the largest fractions`, func(t *testing.T) {
+
+		result := split(10, map[domain.CapacityType]float64{"a": 1, "b": 1, "c": 1})
+		require.Equal(t, map[domain.CapacityType]domain.Tokens{"a": 4, "b": 3, "c": 3}, result)
+
+		result = split(10, map[domain.CapacityType]float64{"a": 1, "b": 2})
+		require.Equal(t, map[domain.CapacityType]domain.Tokens{"a": 3, "b": 7}, result)
+	})
+
+	t.Run(`Given zero weights
+		When the tokens are split
+		Then the tokens are split evenly`, func(t *testing.T) {
+
+		result := split(4, map[domain.CapacityType]float64{"a": 0, "b": 0})
+		require.Equal(t, map[domain.CapacityType]domain.Tokens{"a": 2, "b": 2}, result)
+	})
+}
+
+func TestOrderingStrategies(t *testing.T) {
+	t.Run(`Given an order requiring two capacity types
+		When proportional to capacity strategy commands
+		Then the producers with the most capacity get bids proportional to the required capacity`, func(t *testing.T) {
+
+		cmd := ProportionalToCapacity{}.Command(testView(domain.UndefinedPrice, domain.UndefinedPrice))
+		require.True(t, cmp.Equal(domain.OrderingAgentCommand{
+			Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{
+				"o1": {"p3": 25, "p2": 76},
+			},
+		}, cmd))
+	})
+
+	t.Run(`Given known cut-off prices
+		When proportional to price strategy commands
+		Then the bids are proportional to the price of the required capacity`, func(t *testing.T) {
+
+		cmd := ProportionalToPrice{}.Command(testView(3, 0.5))
+		require.True(t, cmp.Equal(domain.OrderingAgentCommand{
+			Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{
+				"o1": {"p3": 67, "p2": 34},
+			},
+		}, cmd))
+	})
+
+	t.Run(`Given a capacity without a cut-off price
+		When proportional to price strategy commands
+		Then the capacity is priced at the average known price`, func(t *testing.T) {
+
+		cmd := ProportionalToPrice{}.Command(testView(domain.UndefinedPrice, 2))
+		require.True(t, cmp.Equal(domain.OrderingAgentCommand{
+			Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{
+				"o1": {"p3": 25, "p2": 76},
+			},
+		}, cmd))
+	})
+
+	t.Run(`Given all prices known and enough budget
+		When fixed markup strategy commands
+		Then the marked-up prices are bid and the rest goes to the largest capacity`, func(t *testing.T) {
+
+		cmd := FixedMarkup{0.5}.Command(testView(2, 1))
+		require.True(t, cmp.Equal(domain.OrderingAgentCommand{
+			Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{
+				"o1": {"p3": 30, "p2": 71},
+			},
+		}, cmd))
+	})
+
+	t.Run(`Given a capacity without a cut-off price
+		When fixed markup strategy commands
+		Then the rest of the budget goes to that capacity`, func(t *testing.T) {
+
+		cmd := FixedMarkup{0.5}.Command(testView(2, domain.UndefinedPrice))
+		require.True(t, cmp.Equal(domain.OrderingAgentCommand{
+			Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{
+				"o1": {"p3": 30, "p2": 71},
+			},
+		}, cmd))
+
+		cmd = FixedMarkup{0.5}.Command(testView(domain.UndefinedPrice, 1))
+		require.True(t, cmp.Equal(domain.OrderingAgentCommand{
+			Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{
+				"o1": {"p3": 56, "p2": 45},
+			},
+		}, cmd))
+	})
+
+	t.Run(`Given the budget is less than the marked-up prices
+		When fixed markup strategy commands
+		Then the bids are scaled down to the budget`, func(t *testing.T) {
+
+		cmd := FixedMarkup{0}.Command(testView(20, domain.UndefinedPrice))
+		require.True(t, cmp.Equal(domain.OrderingAgentCommand{
+			Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{
+				"o1": {"p3": 101, "p2": 0},
+			},
+		}, cmd))
+	})
+}
+
+func TestNewOrderingStrategy(t *testing.T) {
+	s, err := NewOrderingStrategy(domain.StrategyConfig{Name: FixedMarkupName, Params: map[string]float64{"markup": 0.2}})
+	require.NoError(t, err)
+	require.Equal(t, FixedMarkup{0.2}, s)
+
+	_, err = NewOrderingStrategy(domain.StrategyConfig{Name: "unknown"})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = NewOrderingStrategy(domain.StrategyConfig{Name: ProportionalToCapacityName, Params: map[string]float64{"markup": 0.2}})
+	require.Error(t, err)
+
+	_, err = NewOrderingStrategy(domain.StrategyConfig{Name: FixedMarkupName, Params: map[string]float64{"markup": -1}})
+	require.Error(t, err)
+}
+
+func TestBots(t *testing.T) {
+	t.Run(`Given a consumer controlled by a bot
+		When several cycles are run
+		Then the bot places the consumer's orders every cycle`, func(t *testing.T) {
+
+		config := &domain.Configuration{
+			CycleEmission: 100,
+			ProcessSheets: []domain.ProcessSheet{
+				{Product: 1, Require: map[domain.CapacityType]domain.Capacity{"1": 10}},
+			},
+			ProducerConfigs: []domain.ProducingAgentConfig{
+				{Id: "p1", Type: "1", Capacity: 100, Degradation: 0},
+			},
+			OrderingBots: map[domain.OrderingAgentId]domain.StrategyConfig{
+				"c1": {Name: ProportionalToCapacityName},
+			},
+		}
+		bots, err := NewBots(config)
+		require.NoError(t, err)
+
+		system := domain.NewSystem(&testIdGenerator{}, config, map[domain.ConsumerId]domain.Consumer{"c1": &testConsumer{id: "c1"}}, nil)
+		for range 3 {
+			require.NoError(t, system.StartOrdering())
+			require.NoError(t, bots.Order(system))
+			result, err := system.CompleteCycle()
+			require.NoError(t, err)
+			require.Equal(t, domain.Score(0), result.Score)
+		}
+		require.Len(t, system.MarketHistory(0, 10), 3)
+	})
+
+	t.Run(`Given an unknown strategy
+		When bots are created
+		Then an error is returned`, func(t *testing.T) {
+
+		_, err := NewBots(&domain.Configuration{OrderingBots: map[domain.OrderingAgentId]domain.StrategyConfig{
+			"c1": {Name: "unknown"},
+		}})
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
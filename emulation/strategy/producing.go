@@ -0,0 +1,120 @@
+package strategy
+
+import (
+	"fmt"
+
+	"emulation/domain"
+)
+
+const (
+	NeverInvestName     = "never-invest"
+	RestoreBelowName    = "restore-below"
+	UpgradeOnDemandName = "upgrade-on-demand"
+	ThresholdName       = "threshold"
+)
+
+// ProducingStrategy decides whether a producing agent invests into restoration or upgrade.
+// It is asked once per cycle, so it may keep state between the cycles.
+type ProducingStrategy interface {
+	Command(view domain.ProducingAgentView) domain.ProducingAgentCommand
+}
+
+// NewProducingStrategy creates the built-in producing strategy selected by the config
+func NewProducingStrategy(config domain.StrategyConfig) (ProducingStrategy, error) {
+	switch config.Name {
+	case NeverInvestName:
+		if err := checkParams(config); err != nil {
+			return nil, err
+		}
+		return NeverInvest{}, nil
+	case RestoreBelowName:
+		if err := checkParams(config, "below"); err != nil {
+			return nil, err
+		}
+		below, err := fraction(config, "below", 0.8)
+		if err != nil {
+			return nil, err
+		}
+		return &Threshold{RestoreBelow: below}, nil
+	case UpgradeOnDemandName:
+		if err := checkParams(config, "cycles"); err != nil {
+			return nil, err
+		}
+		cycles, err := positiveInt(config, "cycles", 3)
+		if err != nil {
+			return nil, err
+		}
+		return &Threshold{UpgradeDemand: 1, UpgradeCycles: cycles}, nil
+	case ThresholdName:
+		if err := checkParams(config, "restoreBelow", "upgradeDemand", "upgradeCycles"); err != nil {
+			return nil, err
+		}
+		below, err := fraction(config, "restoreBelow", 0.8)
+		if err != nil {
+			return nil, err
+		}
+		demand := paramOr(config.Params, "upgradeDemand", 1.2)
+		if demand < 1 {
+			return nil, fmt.Errorf("strategy [%s]: upgradeDemand must be at least 1, got %v", config.Name, demand)
+		}
+		cycles, err := positiveInt(config, "upgradeCycles", 2)
+		if err != nil {
+			return nil, err
+		}
+		return &Threshold{RestoreBelow: below, UpgradeDemand: demand, UpgradeCycles: cycles}, nil
+	default:
+		return nil, fmt.Errorf("%w: producing strategy [%s]", domain.ErrNotFound, config.Name)
+	}
+}
+
+// NeverInvest never restores nor upgrades the producer
+type NeverInvest struct{}
+
+func (NeverInvest) Command(domain.ProducingAgentView) domain.ProducingAgentCommand {
+	return domain.ProducingAgentCommand{}
+}
+
+// Threshold restores the producer when its capacity falls below RestoreBelow of the max capacity,
+// and upgrades it when the requested capacity exceeds UpgradeDemand times the capacity for UpgradeCycles cycles in a row.
+// Zero RestoreBelow or UpgradeCycles disables the corresponding investment.
+type Threshold struct {
+	RestoreBelow  float64
+	UpgradeDemand float64
+	UpgradeCycles uint
+
+	overdemanded uint
+}
+
+func (t *Threshold) Command(view domain.ProducingAgentView) domain.ProducingAgentCommand {
+	if float64(view.RequestedCapacity) > t.UpgradeDemand*float64(view.Capacity) {
+		t.overdemanded++
+	} else {
+		t.overdemanded = 0
+	}
+	cmd := domain.ProducingAgentCommand{
+		DoRestoration: !bool(view.RestorationRunning) && view.Restoration > 0 &&
+			float64(view.Capacity) < t.RestoreBelow*float64(view.MaxCapacity),
+		DoUpgrade: !bool(view.UpgradeRunning) && view.Upgrade > 0 &&
+			t.UpgradeCycles > 0 && t.overdemanded >= t.UpgradeCycles,
+	}
+	if cmd.DoUpgrade {
+		t.overdemanded = 0
+	}
+	return cmd
+}
+
+func fraction(config domain.StrategyConfig, name string, def float64) (float64, error) {
+	v := paramOr(config.Params, name, def)
+	if v < 0 || v > 1 {
+		return 0, fmt.Errorf("strategy [%s]: %s must be within [0, 1], got %v", config.Name, name, v)
+	}
+	return v, nil
+}
+
+func positiveInt(config domain.StrategyConfig, name string, def float64) (uint, error) {
+	v := paramOr(config.Params, name, def)
+	if v < 1 || v != float64(uint(v)) {
+		return 0, fmt.Errorf("strategy [%s]: %s must be a positive integer, got %v", config.Name, name, v)
+	}
+	return uint(v), nil
+}
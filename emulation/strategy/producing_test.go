@@ -0,0 +1,112 @@
+package strategy
+
+import (
+	"testing"
+
+	"emulation/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreshold(t *testing.T) {
+	t.Run(`Given a producer below the restoration threshold
+		When the threshold strategy commands
+		Then the restoration is requested unless it is running already`, func(t *testing.T) {
+
+		s := &Threshold{RestoreBelow: 0.8}
+		view := domain.ProducingAgentView{Id: "p1", MaxCapacity: 100, Capacity: 79, Restoration: 10, Upgrade: 10}
+		require.Equal(t, domain.ProducingAgentCommand{DoRestoration: true}, s.Command(view))
+
+		view.RestorationRunning = true
+		require.Equal(t, domain.ProducingAgentCommand{}, s.Command(view))
+
+		view.RestorationRunning, view.Capacity = false, 80
+		require.Equal(t, domain.ProducingAgentCommand{}, s.Command(view))
+	})
+
+	t.Run(`Given a producer overdemanded for several cycles
+		When the threshold strategy commands
+		Then the upgrade is requested after the configured number of cycles`, func(t *testing.T) {
+
+		s := &Threshold{UpgradeDemand: 1.5, UpgradeCycles: 2}
+		overdemanded := domain.ProducingAgentView{Id: "p1", MaxCapacity: 100, Capacity: 100, RequestedCapacity: 151, Upgrade: 10}
+		normal := domain.ProducingAgentView{Id: "p1", MaxCapacity: 100, Capacity: 100, RequestedCapacity: 150, Upgrade: 10}
+
+		require.Equal(t, domain.ProducingAgentCommand{}, s.Command(overdemanded))
+		require.Equal(t, domain.ProducingAgentCommand{}, s.Command(normal))
+		require.Equal(t, domain.ProducingAgentCommand{}, s.Command(overdemanded))
+		require.Equal(t, domain.ProducingAgentCommand{DoUpgrade: true}, s.Command(overdemanded))
+		require.Equal(t, domain.ProducingAgentCommand{}, s.Command(overdemanded))
+	})
+
+	t.Run(`Given a producer without an upgrade nor a restoration configured
+		When the threshold strategy commands
+		Then nothing is requested`, func(t *testing.T) {
+
+		s := &Threshold{RestoreBelow: 1, UpgradeDemand: 1, UpgradeCycles: 1}
+		view := domain.ProducingAgentView{Id: "p1", MaxCapacity: 100, Capacity: 10, RequestedCapacity: 200}
+		require.Equal(t, domain.ProducingAgentCommand{}, s.Command(view))
+	})
+}
+
+func TestNewProducingStrategy(t *testing.T) {
+	s, err := NewProducingStrategy(domain.StrategyConfig{Name: UpgradeOnDemandName, Params: map[string]float64{"cycles": 4}})
+	require.NoError(t, err)
+	require.Equal(t, &Threshold{UpgradeDemand: 1, UpgradeCycles: 4}, s)
+
+	s, err = NewProducingStrategy(domain.StrategyConfig{Name: ThresholdName})
+	require.NoError(t, err)
+	require.Equal(t, &Threshold{RestoreBelow: 0.8, UpgradeDemand: 1.2, UpgradeCycles: 2}, s)
+
+	_, err = NewProducingStrategy(domain.StrategyConfig{Name: "unknown"})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = NewProducingStrategy(domain.StrategyConfig{Name: RestoreBelowName, Params: map[string]float64{"below": 1.5}})
+	require.Error(t, err)
+
+	_, err = NewProducingStrategy(domain.StrategyConfig{Name: UpgradeOnDemandName, Params: map[string]float64{"cycles": 0.5}})
+	require.Error(t, err)
+}
+
+func TestProducingBots(t *testing.T) {
+	t.Run(`Given a degrading producer and all agents controlled by bots
+		When several cycles are run without any external command
+		Then the restorations compensate the producer's degradation`, func(t *testing.T) {
+
+		config := &domain.Configuration{
+			CycleEmission: 100,
+			ProcessSheets: []domain.ProcessSheet{
+				{Product: 1, Require: map[domain.CapacityType]domain.Capacity{"1": 10}},
+				{Product: 2, Require: map[domain.CapacityType]domain.Capacity{"2": 10}},
+			},
+			ProducerConfigs: []domain.ProducingAgentConfig{
+				{Id: "p1", Type: "1", Capacity: 100, Degradation: 10, Restoration: domain.Restoration{Require: 2, Restores: 10}},
+				{Id: "p2", Type: "2", Capacity: 100},
+			},
+			Consumers: []domain.ConsumerConfig{{Id: "c1", Products: []domain.Product{1}}},
+			OrderingBots: map[domain.OrderingAgentId]domain.StrategyConfig{
+				"c1": {Name: ProportionalToCapacityName},
+				"p1": {Name: ProportionalToCapacityName},
+			},
+			ProducingBots: map[domain.ProducerId]domain.StrategyConfig{
+				"p1": {Name: RestoreBelowName, Params: map[string]float64{"below": 1}},
+				"p2": {Name: NeverInvestName},
+			},
+		}
+		require.NoError(t, config.Validate())
+		bots, err := NewBots(config)
+		require.NoError(t, err)
+
+		system := domain.NewSystem(&testIdGenerator{}, config, domain.NewConsumers(config.Consumers, 1), nil)
+		capacities := []domain.Capacity{}
+		for range 4 {
+			require.NoError(t, bots.Invest(system))
+			require.NoError(t, system.StartOrdering())
+			require.NoError(t, bots.Order(system))
+			_, err := system.CompleteCycle()
+			require.NoError(t, err)
+			capacities = append(capacities, system.GetProducerInfos()["p1"].Capacity)
+		}
+		require.Equal(t, []domain.Capacity{90, 90, 90, 90}, capacities)
+	})
+}
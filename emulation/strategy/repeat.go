@@ -0,0 +1,48 @@
+package strategy
+
+import (
+	"emulation/domain"
+)
+
+// RepeatOrdering repeats the producers choice of the last command on the new orders: every required capacity
+// is bought from the producer paid the most by the last command, or from the one with the most capacity
+// if none of its type was paid. The tokens are split as ProportionalToCapacity does.
+type RepeatOrdering struct {
+	Last domain.OrderingAgentCommand
+}
+
+func (r RepeatOrdering) Command(view domain.OrderingAgentView) domain.OrderingAgentCommand {
+	paid := map[domain.ProducerId]domain.Tokens{}
+	for _, bids := range r.Last.Orders {
+		for producerId, tokens := range bids {
+			paid[producerId] += tokens
+		}
+	}
+	pick := func(producers map[domain.ProducerId]domain.ProducerInfo) domain.ProducerInfo {
+		var result domain.ProducerInfo
+		for _, id := range sortedKeys(producers) {
+			if paid[id] > 0 && (result.Id == "" || paid[id] > paid[result.Id]) {
+				result = producers[id]
+			}
+		}
+		if result.Id == "" {
+			return pickProducer(producers)
+		}
+		return result
+	}
+	return commandWith(view, pick, func(tokens domain.Tokens, parts map[domain.CapacityType]orderPart) map[domain.CapacityType]domain.Tokens {
+		return split(tokens, capacityWeights(parts))
+	})
+}
+
+// RepeatProducing repeats the last command skipping the investments which are running already
+type RepeatProducing struct {
+	Last domain.ProducingAgentCommand
+}
+
+func (r RepeatProducing) Command(view domain.ProducingAgentView) domain.ProducingAgentCommand {
+	return domain.ProducingAgentCommand{
+		DoRestoration: r.Last.DoRestoration && !bool(view.RestorationRunning) && view.Restoration > 0,
+		DoUpgrade:     r.Last.DoUpgrade && !bool(view.UpgradeRunning) && view.Upgrade > 0,
+	}
+}
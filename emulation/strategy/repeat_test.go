@@ -0,0 +1,47 @@
+package strategy
+
+import (
+	"testing"
+
+	"emulation/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepeatOrdering(t *testing.T) {
+	t.Run(`Given no last command
+		When the repeat strategy commands
+		Then it orders as the proportional to capacity strategy`, func(t *testing.T) {
+
+		view := testView(1, 1)
+		require.Equal(t, ProportionalToCapacity{}.Command(view), RepeatOrdering{}.Command(view))
+	})
+
+	t.Run(`Given the last command paid a producer with less capacity
+		When the repeat strategy commands
+		Then the new order goes to the same producer`, func(t *testing.T) {
+
+		last := domain.OrderingAgentCommand{Orders: map[domain.OrderId]map[domain.ProducerId]domain.Tokens{
+			"o0": {"p1": 10, "p3": 5, "p2": 20},
+		}}
+		cmd := RepeatOrdering{Last: last}.Command(testView(1, 1))
+		require.Len(t, cmd.Orders, 1)
+		require.ElementsMatch(t, []domain.ProducerId{"p1", "p2"}, sortedKeys(cmd.Orders["o1"]))
+	})
+}
+
+func TestRepeatProducing(t *testing.T) {
+	t.Run(`Given the last command requested both investments
+		When the repeat strategy commands
+		Then only the investments which aren't running are requested`, func(t *testing.T) {
+
+		s := RepeatProducing{Last: domain.ProducingAgentCommand{DoRestoration: true, DoUpgrade: true}}
+		view := domain.ProducingAgentView{Id: "p1", Restoration: 10, Upgrade: 10, UpgradeRunning: true}
+		require.Equal(t, domain.ProducingAgentCommand{DoRestoration: true}, s.Command(view))
+
+		view.UpgradeRunning, view.Restoration = false, 0
+		require.Equal(t, domain.ProducingAgentCommand{DoUpgrade: true}, s.Command(view))
+
+		require.Equal(t, domain.ProducingAgentCommand{}, RepeatProducing{}.Command(view))
+	})
+}
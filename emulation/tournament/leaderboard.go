@@ -0,0 +1,116 @@
+package tournament
+
+import (
+	"cmp"
+	"encoding/csv"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/samber/lo"
+)
+
+// Standing is an entrant's record over all the played matches. A win gives a point, a draw gives a half.
+type Standing struct {
+	Rank    int     `json:"rank"`
+	Entrant string  `json:"entrant"`
+	Played  int     `json:"played"`
+	Wins    int     `json:"wins"`
+	Draws   int     `json:"draws"`
+	Losses  int     `json:"losses"`
+	Points  float64 `json:"points"`
+	// Score is the mean score of the entrant's players per match
+	Score float64 `json:"score"`
+	// Satisfaction is the share of the completed requests of the entrant's consumers
+	Satisfaction float64 `json:"satisfaction"`
+	// Stability is the mean standard deviation of the players' cycle score
+	Stability float64 `json:"stability"`
+}
+
+// newLeaderboard ranks the entrants by points, then by the lower score. Byes count as wins without the stats.
+func newLeaderboard(settings Settings, matches []Match) []Standing {
+	standings := lo.SliceToMap(settings.Entrants, func(e Entrant) (string, *Standing) {
+		return e.Name, &Standing{Entrant: e.Name}
+	})
+	matched := map[string]int{}
+	completed := map[string]uint{}
+	requests := map[string]uint{}
+	for _, m := range matches {
+		if len(m.Sides) == 1 {
+			s := standings[m.Sides[0].Entrant]
+			s.Played++
+			s.Wins++
+			s.Points++
+			continue
+		}
+		for _, side := range m.Sides {
+			s := standings[side.Entrant]
+			s.Played++
+			switch m.Winner {
+			case "":
+				s.Draws++
+				s.Points += 0.5
+			case side.Entrant:
+				s.Wins++
+				s.Points++
+			default:
+				s.Losses++
+			}
+			matched[side.Entrant]++
+			s.Score += float64(side.Score)
+			s.Stability += side.Stability
+			completed[side.Entrant] += side.Completed
+			requests[side.Entrant] += side.Requests
+		}
+	}
+
+	result := lo.Map(settings.Entrants, func(e Entrant, _ int) Standing {
+		s := *standings[e.Name]
+		if matched[e.Name] > 0 {
+			s.Score /= float64(matched[e.Name])
+			s.Stability /= float64(matched[e.Name])
+		}
+		if requests[e.Name] > 0 {
+			s.Satisfaction = float64(completed[e.Name]) / float64(requests[e.Name])
+		}
+		return s
+	})
+	slices.SortStableFunc(result, func(a, b Standing) int {
+		return cmp.Or(cmp.Compare(b.Points, a.Points), cmp.Compare(a.Score, b.Score))
+	})
+	for i := range result {
+		result[i].Rank = i + 1
+	}
+	return result
+}
+
+// WriteCSV writes the leaderboard
+func WriteCSV(w io.Writer, leaderboard []Standing) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"rank", "entrant", "played", "wins", "draws", "losses", "points", "score", "satisfaction", "stability"}); err != nil {
+		return err
+	}
+	for _, s := range leaderboard {
+		row := []string{
+			strconv.Itoa(s.Rank),
+			s.Entrant,
+			strconv.Itoa(s.Played),
+			strconv.Itoa(s.Wins),
+			strconv.Itoa(s.Draws),
+			strconv.Itoa(s.Losses),
+			formatFloat(s.Points),
+			formatFloat(s.Score),
+			formatFloat(s.Satisfaction),
+			formatFloat(s.Stability),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
@@ -0,0 +1,338 @@
+package tournament
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"emulation/domain"
+	"emulation/simulation"
+	"emulation/strategy"
+
+	"github.com/samber/lo"
+	"gonum.org/v1/gonum/stat"
+)
+
+const (
+	RoundRobin = "round-robin"
+	Swiss      = "swiss"
+)
+
+// Entrant is a named assignment of strategies to the players' agents.
+// An omitted strategy keeps the bot of the base configuration.
+type Entrant struct {
+	Name      string                 `json:"name"`
+	Ordering  *domain.StrategyConfig `json:"ordering,omitempty"`
+	Producing *domain.StrategyConfig `json:"producing,omitempty"`
+}
+
+// Settings of a tournament. Every match is played with every seed twice, the players swap the sides in the rematch.
+type Settings struct {
+	Format string `json:"format"`
+	// Rounds of a Swiss tournament, a round-robin one plays all the pairs
+	Rounds   int       `json:"rounds,omitempty"`
+	Entrants []Entrant `json:"entrants"`
+	Cycles   uint      `json:"cycles"`
+	Seeds    int       `json:"seeds"`
+	Seed     uint64    `json:"seed"`
+	Workers  int       `json:"workers,omitempty"`
+}
+
+func (s *Settings) Validate() error {
+	switch s.Format {
+	case RoundRobin:
+	case Swiss:
+		if s.Rounds < 1 {
+			return fmt.Errorf("swiss tournament rounds must be positive, got %d", s.Rounds)
+		}
+	default:
+		return fmt.Errorf("unknown tournament format [%s]", s.Format)
+	}
+	if s.Cycles == 0 || s.Seeds < 1 {
+		return fmt.Errorf("cycles and seeds must be positive, got %d and %d", s.Cycles, s.Seeds)
+	}
+	if len(s.Entrants) < 2 {
+		return fmt.Errorf("at least 2 entrants are required, got %d", len(s.Entrants))
+	}
+	names := map[string]bool{}
+	for _, e := range s.Entrants {
+		if e.Name == "" || names[e.Name] {
+			return fmt.Errorf("entrant names must be unique and non-empty, got [%s]", e.Name)
+		}
+		names[e.Name] = true
+		if e.Ordering == nil && e.Producing == nil {
+			return fmt.Errorf("entrant [%s] has no strategies", e.Name)
+		}
+		if e.Ordering != nil {
+			if _, err := strategy.NewOrderingStrategy(*e.Ordering); err != nil {
+				return fmt.Errorf("entrant [%s]: %w", e.Name, err)
+			}
+		}
+		if e.Producing != nil {
+			if _, err := strategy.NewProducingStrategy(*e.Producing); err != nil {
+				return fmt.Errorf("entrant [%s]: %w", e.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// SideResult is the outcome of an entrant's players summed over the games of a match
+type SideResult struct {
+	Entrant string `json:"entrant"`
+	// Score of the orders of the players' ordering agents, the lower the better
+	Score domain.Score `json:"score"`
+	// Completed and Requests count the finished requests of the players' consumers
+	Completed uint `json:"completed"`
+	Requests  uint `json:"requests"`
+	// Stability is the mean standard deviation of the players' cycle score, the lower the better
+	Stability float64 `json:"stability"`
+}
+
+// Match is a pair of entrants played against each other, the entrant with the lower score wins.
+// A bye has a single side and is won by it.
+type Match struct {
+	Round  int          `json:"round"`
+	Sides  []SideResult `json:"sides"`
+	Winner string       `json:"winner,omitempty"`
+}
+
+type Result struct {
+	Leaderboard []Standing `json:"leaderboard"`
+	Matches     []Match    `json:"matches"`
+}
+
+// Run plays the tournament on the base configuration, the players are its consumers and producers
+func Run(config *domain.Configuration, settings Settings) (Result, error) {
+	if err := settings.Validate(); err != nil {
+		return Result{}, err
+	}
+	t := &tournament{config, settings, newSides(config)}
+
+	var matches []Match
+	switch settings.Format {
+	case RoundRobin:
+		for round, pairs := range roundRobin(len(settings.Entrants)) {
+			played, err := t.play(round+1, pairs)
+			if err != nil {
+				return Result{}, err
+			}
+			matches = append(matches, played...)
+		}
+	case Swiss:
+		for round := range settings.Rounds {
+			pairs, bye := swissPairs(settings.Entrants, matches)
+			played, err := t.play(round+1, pairs)
+			if err != nil {
+				return Result{}, err
+			}
+			matches = append(matches, played...)
+			if bye >= 0 {
+				name := settings.Entrants[bye].Name
+				matches = append(matches, Match{round + 1, []SideResult{{Entrant: name}}, name})
+			}
+		}
+	}
+	return Result{newLeaderboard(settings, matches), matches}, nil
+}
+
+type tournament struct {
+	config   *domain.Configuration
+	settings Settings
+	sides    [2]side
+}
+
+// side is a half of the players
+type side struct {
+	consumers []domain.ConsumerId
+	producers []domain.ProducerId
+}
+
+// newSides splits the consumers and the producers alternately
+func newSides(config *domain.Configuration) [2]side {
+	var sides [2]side
+	consumers := slices.Sorted(slices.Values(lo.Map(config.Consumers, func(c domain.ConsumerConfig, _ int) domain.ConsumerId {
+		return c.Id
+	})))
+	for i, id := range consumers {
+		sides[i%2].consumers = append(sides[i%2].consumers, id)
+	}
+	producers := slices.Sorted(slices.Values(lo.Map(config.ProducerConfigs, func(p domain.ProducingAgentConfig, _ int) domain.ProducerId {
+		return p.Id
+	})))
+	for i, id := range producers {
+		sides[i%2].producers = append(sides[i%2].producers, id)
+	}
+	return sides
+}
+
+func (s side) orderingAgents() []domain.OrderingAgentId {
+	return append(
+		lo.Map(s.consumers, func(id domain.ConsumerId, _ int) domain.OrderingAgentId { return domain.FromConsumerId(id) }),
+		lo.Map(s.producers, func(id domain.ProducerId, _ int) domain.OrderingAgentId { return domain.FromProducerId(id) })...)
+}
+
+// play runs all games of the pairs in parallel
+func (t *tournament) play(round int, pairs [][2]int) ([]Match, error) {
+	// a game per pair, seed and sides swap
+	games := 2 * t.settings.Seeds
+	results := make([][2]SideResult, len(pairs)*games)
+	err := simulation.ForEach(len(results), t.settings.Workers, func(i int) error {
+		pair, game := pairs[i/games], i%games
+		seed := t.settings.Seed + uint64(game/2)
+		// entrants[k] plays the side k in the first game and the other one in the rematch
+		entrants := [2]Entrant{t.settings.Entrants[pair[game%2]], t.settings.Entrants[pair[1-game%2]]}
+		result, err := t.game(entrants, seed)
+		if err != nil {
+			return fmt.Errorf("round %d, %s vs %s, seed %d: %w", round, entrants[0].Name, entrants[1].Name, seed, err)
+		}
+		if game%2 == 1 {
+			result[0], result[1] = result[1], result[0]
+		}
+		results[i] = result
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(pairs, func(pair [2]int, i int) Match {
+		match := Match{Round: round, Sides: make([]SideResult, 2)}
+		for k := range 2 {
+			match.Sides[k].Entrant = t.settings.Entrants[pair[k]].Name
+			for _, r := range results[i*games : (i+1)*games] {
+				match.Sides[k].Score += r[k].Score
+				match.Sides[k].Completed += r[k].Completed
+				match.Sides[k].Requests += r[k].Requests
+				match.Sides[k].Stability += r[k].Stability / float64(games)
+			}
+		}
+		if match.Sides[0].Score != match.Sides[1].Score {
+			match.Winner = lo.MinBy(match.Sides, func(a, b SideResult) bool { return a.Score < b.Score }).Entrant
+		}
+		return match
+	}), nil
+}
+
+// game plays a single simulation with the entrants' strategies assigned to the sides
+func (t *tournament) game(entrants [2]Entrant, seed uint64) ([2]SideResult, error) {
+	config := *t.config
+	config.OrderingBots = maps.Clone(config.OrderingBots)
+	config.ProducingBots = maps.Clone(config.ProducingBots)
+	if config.OrderingBots == nil {
+		config.OrderingBots = map[domain.OrderingAgentId]domain.StrategyConfig{}
+	}
+	if config.ProducingBots == nil {
+		config.ProducingBots = map[domain.ProducerId]domain.StrategyConfig{}
+	}
+	for k, s := range t.sides {
+		if entrants[k].Ordering != nil {
+			for _, id := range s.orderingAgents() {
+				config.OrderingBots[id] = *entrants[k].Ordering
+			}
+		}
+		if entrants[k].Producing != nil {
+			for _, id := range s.producers {
+				config.ProducingBots[id] = *entrants[k].Producing
+			}
+		}
+	}
+
+	var result [2]SideResult
+	var scores [2][]float64
+	_, err := simulation.Run(&config, simulation.Options{
+		Cycles: t.settings.Cycles,
+		Seed:   seed,
+		OnCycle: func(_ uint, cycle domain.CycleResult) {
+			for k, s := range t.sides {
+				score := domain.Score(0)
+				for _, id := range s.orderingAgents() {
+					score += cycle.AgentScores[id]
+				}
+				for _, id := range s.consumers {
+					completed := cycle.Completed[domain.FromConsumerId(id)]
+					result[k].Completed += completed
+					result[k].Requests += completed + cycle.Rejected[domain.FromConsumerId(id)]
+				}
+				result[k].Score += score
+				scores[k] = append(scores[k], float64(score))
+			}
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+	for k := range result {
+		if len(scores[k]) > 1 {
+			result[k].Stability = stat.StdDev(scores[k], nil)
+		}
+	}
+	return result, nil
+}
+
+// roundRobin schedules all the pairs with the circle method, every entrant plays once per round
+// unless the number of entrants is odd
+func roundRobin(n int) [][][2]int {
+	ids := lo.Range(n)
+	if n%2 == 1 {
+		// -1 is the bye
+		ids = append(ids, -1)
+	}
+	var rounds [][][2]int
+	for range len(ids) - 1 {
+		var pairs [][2]int
+		for i := range len(ids) / 2 {
+			a, b := ids[i], ids[len(ids)-1-i]
+			if a >= 0 && b >= 0 {
+				pairs = append(pairs, [2]int{min(a, b), max(a, b)})
+			}
+		}
+		rounds = append(rounds, pairs)
+		// keep the first one, rotate the rest
+		ids = append([]int{ids[0], ids[len(ids)-1]}, ids[1:len(ids)-1]...)
+	}
+	return rounds
+}
+
+// swissPairs pairs the entrants of close standings avoiding rematches where possible.
+// With an odd number of entrants the lowest ranked one without a bye sits out, -1 means no bye.
+func swissPairs(entrants []Entrant, played []Match) ([][2]int, int) {
+	n := len(entrants)
+	standings := newLeaderboard(Settings{Entrants: entrants}, played)
+	index := lo.SliceToMap(lo.Range(n), func(i int) (string, int) { return entrants[i].Name, i })
+	ranked := lo.Map(standings, func(s Standing, _ int) int { return index[s.Entrant] })
+
+	met := map[[2]int]bool{}
+	hadBye := map[int]bool{}
+	for _, m := range played {
+		if len(m.Sides) == 1 {
+			hadBye[index[m.Sides[0].Entrant]] = true
+			continue
+		}
+		a, b := index[m.Sides[0].Entrant], index[m.Sides[1].Entrant]
+		met[[2]int{a, b}], met[[2]int{b, a}] = true, true
+	}
+
+	bye := -1
+	if n%2 == 1 {
+		bye = ranked[len(ranked)-1]
+		for i := len(ranked) - 1; i >= 0; i-- {
+			if !hadBye[ranked[i]] {
+				bye = ranked[i]
+				break
+			}
+		}
+		ranked = slices.DeleteFunc(ranked, func(i int) bool { return i == bye })
+	}
+
+	var pairs [][2]int
+	for len(ranked) > 0 {
+		a := ranked[0]
+		j := slices.IndexFunc(ranked[1:], func(b int) bool { return !met[[2]int{a, b}] })
+		// everybody has been met already, take the closest one
+		j = max(j, 0) + 1
+		pairs = append(pairs, [2]int{a, ranked[j]})
+		ranked = slices.Delete(ranked, j, j+1)[1:]
+	}
+	return pairs, bye
+}
@@ -0,0 +1,127 @@
+package tournament
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"emulation/domain"
+	"emulation/domain/domaintest"
+	"emulation/strategy"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func entrant(name, ordering, producing string) Entrant {
+	return Entrant{name, &domain.StrategyConfig{Name: ordering}, &domain.StrategyConfig{Name: producing}}
+}
+
+func testSettings(format string, entrants ...Entrant) Settings {
+	return Settings{Format: format, Rounds: 2, Entrants: entrants, Cycles: 20, Seeds: 2, Seed: 1}
+}
+
+func TestRoundRobin(t *testing.T) {
+	for _, n := range []int{2, 4, 5} {
+		rounds := roundRobin(n)
+		pairs := lo.Flatten(rounds)
+		require.Len(t, pairs, n*(n-1)/2)
+		require.Equal(t, pairs, lo.Uniq(pairs))
+		for _, round := range rounds {
+			entrants := lo.FlatMap(round, func(p [2]int, _ int) []int { return p[:] })
+			require.Equal(t, entrants, lo.Uniq(entrants))
+		}
+	}
+}
+
+func TestSwissPairs(t *testing.T) {
+	entrants := []Entrant{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	played := []Match{
+		{1, []SideResult{{Entrant: "a"}, {Entrant: "b"}}, "a"},
+		{1, []SideResult{{Entrant: "c"}}, "c"},
+	}
+
+	pairs, bye := swissPairs(entrants, played)
+	require.Equal(t, 1, bye)
+	require.Equal(t, [][2]int{{0, 2}}, pairs)
+}
+
+func TestRun(t *testing.T) {
+	t.Run(`Given a round-robin tournament
+		When it is run
+		Then every pair plays a match
+		And the leaderboard is ranked by points
+		And the same settings give the same result`, func(t *testing.T) {
+
+		settings := testSettings(RoundRobin,
+			entrant("capacity", strategy.ProportionalToCapacityName, strategy.RestoreBelowName),
+			entrant("price", strategy.ProportionalToPriceName, strategy.ThresholdName),
+			entrant("idle", strategy.ProportionalToCapacityName, strategy.NeverInvestName))
+		result, err := Run(domaintest.Economy(), settings)
+		require.NoError(t, err)
+		require.Len(t, result.Matches, 3)
+		require.Len(t, result.Leaderboard, 3)
+		require.Equal(t, 3.0, lo.SumBy(result.Leaderboard, func(s Standing) float64 { return s.Points }))
+		for i, s := range result.Leaderboard {
+			require.Equal(t, i+1, s.Rank)
+			require.Equal(t, 2, s.Played)
+			require.Positive(t, s.Satisfaction)
+			if i > 0 {
+				require.LessOrEqual(t, s.Points, result.Leaderboard[i-1].Points)
+			}
+		}
+
+		again, err := Run(domaintest.Economy(), settings)
+		require.NoError(t, err)
+		require.Equal(t, result, again)
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteCSV(&buf, result.Leaderboard))
+		require.Len(t, strings.Split(strings.TrimSpace(buf.String()), "\n"), 4)
+	})
+
+	t.Run(`Given entrants with the same strategies
+		When they play a match
+		Then it is a draw as the rematch swaps the sides`, func(t *testing.T) {
+
+		result, err := Run(domaintest.Economy(), testSettings(RoundRobin,
+			entrant("a", strategy.ProportionalToCapacityName, strategy.RestoreBelowName),
+			entrant("b", strategy.ProportionalToCapacityName, strategy.RestoreBelowName)))
+		require.NoError(t, err)
+		require.Len(t, result.Matches, 1)
+		require.Empty(t, result.Matches[0].Winner)
+		require.Equal(t, result.Matches[0].Sides[0].Score, result.Matches[0].Sides[1].Score)
+	})
+
+	t.Run(`Given a Swiss tournament with an odd number of entrants
+		When it is run
+		Then every round has a bye`, func(t *testing.T) {
+
+		result, err := Run(domaintest.Economy(), testSettings(Swiss,
+			entrant("capacity", strategy.ProportionalToCapacityName, strategy.RestoreBelowName),
+			entrant("price", strategy.ProportionalToPriceName, strategy.ThresholdName),
+			entrant("idle", strategy.ProportionalToCapacityName, strategy.NeverInvestName)))
+		require.NoError(t, err)
+		require.Len(t, result.Matches, 4)
+		byes := lo.Filter(result.Matches, func(m Match, _ int) bool { return len(m.Sides) == 1 })
+		require.Len(t, byes, 2)
+		require.NotEqual(t, byes[0].Winner, byes[1].Winner)
+	})
+
+	t.Run(`Given invalid settings
+		When the tournament is run
+		Then an error is returned`, func(t *testing.T) {
+
+		valid := entrant("a", strategy.ProportionalToCapacityName, strategy.RestoreBelowName)
+		for _, settings := range []Settings{
+			testSettings("knockout", valid, entrant("b", strategy.ProportionalToPriceName, strategy.ThresholdName)),
+			testSettings(RoundRobin, valid),
+			testSettings(RoundRobin, valid, valid),
+			testSettings(RoundRobin, valid, entrant("b", "unknown", strategy.ThresholdName)),
+			testSettings(RoundRobin, valid, Entrant{Name: "b"}),
+		} {
+			_, err := Run(domaintest.Economy(), settings)
+			require.Error(t, err)
+		}
+	})
+}